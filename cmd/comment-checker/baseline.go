@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/baseline"
+	"github.com/spf13/cobra"
+)
+
+var (
+	baselinePath   string
+	baselineOutput string
+)
+
+func newBaselineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "Manage the baseline of grandfathered comments",
+	}
+
+	create := &cobra.Command{
+		Use:   "create [paths...]",
+		Short: "Record the current problematic comments in a baseline file",
+		Long:  "Scans the given files and directories (default: current directory) and writes every problematic comment to a baseline file. scan, diff and pre-commit then ignore those comments and only report new ones.",
+		Run:   runBaselineCreate,
+	}
+	create.Flags().StringVarP(&baselineOutput, "output", "o", baseline.FileName, "Path of the baseline file to write")
+	cmd.AddCommand(create)
+
+	return cmd
+}
+
+// addBaselineFlag registers the --baseline flag on a reporting subcommand.
+func addBaselineFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&baselinePath, "baseline", "", "Baseline file of comments to ignore (default: nearest "+baseline.FileName+")")
+}
+
+func runBaselineCreate(cmd *cobra.Command, args []string) {
+	absOutput, err := filepath.Abs(baselineOutput)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+		return
+	}
+
+	result := checkFiles(args, nil, nil)
+
+	b := baseline.New(filepath.Dir(absOutput))
+	for _, comment := range result.flagged {
+		b.Add(comment.FilePath, comment)
+	}
+	if err := b.Save(absOutput); err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "[check-comments] Success: Recorded %d comment(s) from %d file(s) in %s\n", b.Len(), result.files, baselineOutput)
+	os.Exit(exitPass)
+}
+
+// loadBaseline loads the --baseline file, or the nearest baseline file above the
+// current directory. Returns nil when there is none.
+func loadBaseline() *baseline.Baseline {
+	path := baselinePath
+	if path == "" {
+		path = baseline.Find(".")
+		if path == "" {
+			return nil
+		}
+	}
+
+	b, err := baseline.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: Invalid baseline file: %v\n", err)
+		os.Exit(exitFail)
+	}
+	return b
+}
+
+// reportStaleBaseline warns about baseline entries whose comments were deleted.
+func reportStaleBaseline(b *baseline.Baseline) {
+	if b == nil {
+		return
+	}
+	stale := b.Stale()
+	if len(stale) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "[check-comments] Warning: %d stale baseline entr(ies) no longer match a comment; run `comment-checker baseline create` to refresh:\n", len(stale))
+	for _, entry := range stale {
+		location := entry.File
+		if entry.Scope != "" {
+			location += " (" + entry.Scope + ")"
+		}
+		if entry.Count > 1 {
+			location += fmt.Sprintf(" x%d", entry.Count)
+		}
+		fmt.Fprintf(os.Stderr, "  %s: %s\n", location, entry.Text)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/config"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/git"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/input"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+	"github.com/spf13/cobra"
+)
+
+var diffStaged bool
+
+func newDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [--staged | <base>..<head>]",
+		Short: "Check only comments added by a git diff",
+		Long:  "Runs git diff against the local repository and reports problematic comments on added lines only. Without arguments, unstaged working tree changes are checked. Exits with code 1 when any are found.",
+		Args:  cobra.MaximumNArgs(1),
+		Run:   runDiff,
+	}
+
+	cmd.Flags().BoolVar(&diffStaged, "staged", false, "Check staged changes instead of the working tree")
+	addReportFormatFlag(cmd)
+	addBaselineFlag(cmd)
+
+	return cmd
+}
+
+func runDiff(cmd *cobra.Command, args []string) {
+	validateReportFormat()
+
+	if diffStaged && len(args) > 0 {
+		fmt.Fprintln(os.Stderr, "[check-comments] Error: --staged cannot be combined with a revision range")
+		os.Exit(exitFail)
+		return
+	}
+
+	root, err := git.TopLevel("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+		return
+	}
+
+	var diffArgs []string
+	if diffStaged {
+		diffArgs = append(diffArgs, "--staged")
+	}
+	diffArgs = append(diffArgs, args...)
+
+	fileDiffs, err := git.Diff(root, diffArgs...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+		return
+	}
+
+	rev, fromGit := revisionForDiff(args)
+	registry := core.NewLanguageRegistry()
+	resolver := config.NewResolver()
+	detector := core.NewCommentDetector()
+
+	result := scanResult{baseline: loadBaseline()}
+	for _, fileDiff := range fileDiffs {
+		if !registry.IsSupported(fileExtension(fileDiff.Path)) {
+			continue
+		}
+
+		var content string
+		if fromGit {
+			content, err = git.ShowFile(root, rev, fileDiff.Path)
+			if err != nil {
+				continue
+			}
+		} else {
+			content = input.ReadFile(filepath.Join(root, fileDiff.Path))
+		}
+
+		rules, err := resolver.RulesFor(filepath.Join(root, fileDiff.Path))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[check-comments] Error: Invalid config file: %v\n", err)
+			os.Exit(exitFail)
+			return
+		}
+
+		detected := detectComments(detector, content, fileDiff.Path, rules)
+		result.add(filepath.Join(root, fileDiff.Path), filterAddedLines(detected, fileDiff.AddedLines), rules)
+	}
+
+	reportFindings(result)
+}
+
+// revisionForDiff returns the revision holding the new side of the diff.
+// The boolean is false when the new side is the working tree, which is read from disk.
+func revisionForDiff(args []string) (string, bool) {
+	if diffStaged {
+		return "", true
+	}
+	if len(args) == 0 {
+		return "", false
+	}
+
+	for _, sep := range []string{"...", ".."} {
+		if _, head, found := strings.Cut(args[0], sep); found {
+			if head == "" {
+				head = "HEAD"
+			}
+			return head, true
+		}
+	}
+
+	// A single revision is compared against the working tree
+	return "", false
+}
+
+// filterAddedLines returns comments spanning at least one of the added lines.
+func filterAddedLines(comments []models.CommentInfo, addedLines map[int]struct{}) []models.CommentInfo {
+	var added []models.CommentInfo
+	for _, c := range comments {
+		for line := c.LineNumber; line <= c.LastLine(); line++ {
+			if _, ok := addedLines[line]; ok {
+				added = append(added, c)
+				break
+			}
+		}
+	}
+	return added
+}
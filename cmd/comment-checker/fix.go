@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/config"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/fix"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scanFix   bool
+	fixDryRun bool
+)
+
+func newFixCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fix [paths...]",
+		Short: "Remove problematic comments from files and directories",
+		Long:  "Removes problematic comments from every supported source file under the given paths (default: current directory). With --dry-run, prints a unified diff instead of writing files. Only error-severity findings are removed, and comments recorded in the baseline are kept. Exits with code 1 when errors remain that could not be removed safely.",
+		Run:   runFix,
+	}
+
+	addDryRunFlag(cmd)
+	addBaselineFlag(cmd)
+
+	return cmd
+}
+
+// addDryRunFlag registers the --dry-run flag on a fixing subcommand.
+func addDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&fixDryRun, "dry-run", false, "Print a unified diff of the fixes instead of writing files")
+}
+
+func runFix(cmd *cobra.Command, args []string) {
+	session := newFixSession()
+	result := checkFiles(args, session, loadBaseline())
+	session.printSummary()
+
+	if len(result.flagged) > 0 {
+		fmt.Fprint(os.Stdout, output.FormatScanReport(result.flagged))
+	}
+	if result.hasErrors() {
+		os.Exit(exitFail)
+		return
+	}
+	os.Exit(exitPass)
+}
+
+// fixSession removes flagged comments file by file and tallies the removals.
+type fixSession struct {
+	fixer   *fix.Fixer
+	removed int
+	files   int
+}
+
+func newFixSession() *fixSession {
+	return &fixSession{fixer: fix.NewFixer(core.NewCommentDetector())}
+}
+
+// fixFile removes the given flagged comments of one file, writing it back or
+// printing a diff in dry-run mode, and returns the comments the file still
+// contains. Directives such as //go:build are never removed, even when flagged.
+func (s *fixSession) fixFile(detector *core.CommentDetector, filePath string, comments, flagged []models.CommentInfo, rules config.Rules) []models.CommentInfo {
+	flagged = withoutDirectives(flagged)
+	if len(flagged) == 0 {
+		return comments
+	}
+
+	// Only rewrite files that round-trip unchanged; Latin-1 files are left alone
+	data, err := os.ReadFile(filePath)
+	if err != nil || !utf8.Valid(data) {
+		return comments
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return comments
+	}
+
+	content := string(data)
+	result := s.fixer.Apply(content, filePath, flagged)
+	if !result.Changed() {
+		return comments
+	}
+
+	if fixDryRun {
+		fmt.Fprint(os.Stdout, fix.UnifiedDiff(filePath, content, result.Content))
+		s.record(result)
+		return withoutComments(comments, result.Removed)
+	}
+
+	if err := os.WriteFile(filePath, []byte(result.Content), info.Mode().Perm()); err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+	}
+	s.record(result)
+	return detectComments(detector, result.Content, filePath, rules)
+}
+
+// record adds one file's removals to the session totals.
+func (s *fixSession) record(result fix.Result) {
+	s.removed += len(result.Removed)
+	s.files++
+}
+
+// printSummary reports how many comments were (or would be) removed.
+func (s *fixSession) printSummary() {
+	verb := "Removed"
+	if fixDryRun {
+		verb = "Would remove"
+	}
+	fmt.Fprintf(os.Stderr, "[check-comments] Success: %s %d comment(s) from %d file(s)\n", verb, s.removed, s.files)
+}
+
+// withoutDirectives returns the comments that are not compiler, build or editor directives.
+func withoutDirectives(comments []models.CommentInfo) []models.CommentInfo {
+	var kept []models.CommentInfo
+	for _, c := range comments {
+		if !fix.IsDirective(c) {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// withoutComments returns comments minus those starting at a removed comment's offset.
+func withoutComments(comments, removed []models.CommentInfo) []models.CommentInfo {
+	removedStarts := make(map[int]struct{}, len(removed))
+	for _, c := range removed {
+		removedStarts[c.StartByte] = struct{}{}
+	}
+
+	var kept []models.CommentInfo
+	for _, c := range comments {
+		if _, ok := removedStarts[c.StartByte]; !ok {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
@@ -8,8 +8,12 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/compare"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/config"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/edits"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/filters"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/input"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/output"
 	"github.com/spf13/cobra"
@@ -17,13 +21,15 @@ import (
 
 // ToolInput represents the tool_input field from JSON input.
 type ToolInput struct {
-	FilePath  string `json:"file_path"`
-	Content   string `json:"content"`
-	NewString string `json:"new_string"`
-	OldString string `json:"old_string"`
-	Edits     []struct {
-		OldString string `json:"old_string"`
-		NewString string `json:"new_string"`
+	FilePath   string `json:"file_path"`
+	Content    string `json:"content"`
+	NewString  string `json:"new_string"`
+	OldString  string `json:"old_string"`
+	ReplaceAll bool   `json:"replace_all"`
+	Edits      []struct {
+		OldString  string `json:"old_string"`
+		NewString  string `json:"new_string"`
+		ReplaceAll bool   `json:"replace_all"`
 	} `json:"edits"`
 }
 
@@ -40,28 +46,66 @@ type HookInput struct {
 
 const (
 	exitPass  = 0
+	exitFail  = 1
 	exitBlock = 2
 )
 
-var customPrompt string
+const (
+	outputFormatText     = "text"
+	outputFormatHookJSON = "hook-json"
+)
+
+// version is set at build time via -ldflags "-X main.version=...".
+var version = "dev"
+
+var (
+	customPrompt    string
+	outputFormat    string
+	nonBlocking     bool
+	disabledFilters []string
+	enabledFilters  []string
+)
 
 func main() {
 	rootCmd := &cobra.Command{
-		Use:   "comment-checker",
-		Short: "Check for problematic comments in source code",
-		Long:  "A hook for Claude Code that detects and warns about comments and docstrings in source code.",
-		Run:   run,
+		Use:     "comment-checker",
+		Short:   "Check for problematic comments in source code",
+		Long:    "A hook for Claude Code that detects and warns about comments and docstrings in source code.",
+		Version: version,
+		Run:     run,
 	}
 
 	rootCmd.Flags().StringVar(&customPrompt, "prompt", "", "Custom prompt to replace the default warning message. Use {{comments}} placeholder for detected comments XML.")
 
-	if err := rootCmd.Execute(); err != nil {
+	rootCmd.Flags().StringVar(&outputFormat, "output-format", outputFormatText, "Hook output format: text (stderr message, exit 2) or hook-json (Claude Code decision JSON on stdout, exit 0).")
+	rootCmd.Flags().BoolVar(&nonBlocking, "non-blocking", false, "With --output-format=hook-json, pass findings to the agent as additionalContext instead of blocking.")
+	rootCmd.PersistentFlags().StringSliceVar(&disabledFilters, "disable-filter", nil, "Disable a filter by name (e.g. bdd, directive, shebang, allow-pattern). Repeatable.")
+	rootCmd.PersistentFlags().StringSliceVar(&enabledFilters, "enable-filter", nil, "Enable a filter disabled by the config file. Repeatable.")
+
+	rootCmd.AddCommand(newScanCmd())
+	rootCmd.AddCommand(newDiffCmd())
+	rootCmd.AddCommand(newPreCommitCmd())
+	rootCmd.AddCommand(newFixCmd())
+	rootCmd.AddCommand(newBaselineCmd())
+
+	// The hook fails open so a broken invocation never blocks the agent;
+	// subcommands run in CI, where a usage error must not pass silently.
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		if cmd != rootCmd {
+			os.Exit(exitFail)
+		}
 		fmt.Fprintln(os.Stderr, "[check-comments] Skipping: Command execution failed")
 		os.Exit(exitPass)
 	}
 }
 
 func run(cmd *cobra.Command, args []string) {
+	if outputFormat != outputFormatText && outputFormat != outputFormatHookJSON {
+		fmt.Fprintf(os.Stderr, "[check-comments] Skipping: Unknown output format %q\n", outputFormat)
+		os.Exit(exitPass)
+		return
+	}
+
 	// Read JSON from stdin
 	input, err := io.ReadAll(os.Stdin)
 	if err != nil {
@@ -94,19 +138,21 @@ func run(cmd *cobra.Command, args []string) {
 	}
 
 	// Check if file is a code file (supported extension)
-	ext := strings.TrimPrefix(filepath.Ext(filePath), ".")
-	if ext == "" {
-		// Handle files like "Dockerfile"
-		ext = strings.ToLower(filepath.Base(filePath))
-	}
-
 	registry := core.NewLanguageRegistry()
-	if !registry.IsSupported(ext) {
+	if !registry.IsSupported(fileExtension(filePath)) {
 		fmt.Fprintln(os.Stderr, "[check-comments] Skipping: Non-code file")
 		os.Exit(exitPass)
 		return
 	}
 
+	// Load project configuration nearest to the edited file
+	rules, err := config.NewResolver().RulesFor(resolveHookPath(hookInput.Cwd, filePath))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Skipping: Invalid config file: %v\n", err)
+		os.Exit(exitPass)
+		return
+	}
+
 	// Detect comments based on tool type
 	detector := core.NewCommentDetector()
 	var comments []models.CommentInfo
@@ -119,31 +165,15 @@ func run(cmd *cobra.Command, args []string) {
 			os.Exit(exitPass)
 			return
 		}
-		comments = detectNewCommentsForEdit(
-			detector,
-			hookInput.ToolInput.OldString,
-			hookInput.ToolInput.NewString,
-			filePath,
-		)
+		comments = detectEditComments(detector, hookInput, toolEdits(hookInput), rules)
 	case "MultiEdit":
-		// For MultiEdit: aggregate new comments from all edits
+		// For MultiEdit: replay the edits in order and detect new comments in the result
 		if len(hookInput.ToolInput.Edits) == 0 {
 			fmt.Fprintln(os.Stderr, "[check-comments] Skipping: No content to check")
 			os.Exit(exitPass)
 			return
 		}
-		for _, edit := range hookInput.ToolInput.Edits {
-			if edit.NewString == "" {
-				continue
-			}
-			editComments := detectNewCommentsForEdit(
-				detector,
-				edit.OldString,
-				edit.NewString,
-				filePath,
-			)
-			comments = append(comments, editComments...)
-		}
+		comments = detectEditComments(detector, hookInput, toolEdits(hookInput), rules)
 	default:
 		// For Write and others: check entire content
 		content := getContentToCheck(hookInput)
@@ -152,7 +182,7 @@ func run(cmd *cobra.Command, args []string) {
 			os.Exit(exitPass)
 			return
 		}
-		comments = detector.Detect(content, filePath, true)
+		comments = detectComments(detector, content, filePath, rules)
 	}
 
 	// No comments found
@@ -162,8 +192,8 @@ func run(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	// Apply filter chain: BDD -> Directive -> Shebang
-	filtered := applyFilters(comments)
+	// Apply filter chain: BDD -> Directive -> Shebang -> registered filters -> allow patterns
+	filtered := applyFilters(comments, rules)
 
 	// No problematic comments after filtering
 	if len(filtered) == 0 {
@@ -172,12 +202,156 @@ func run(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	// Only errors block; warnings are passed to the agent and info findings are dropped
+	errors, warnings := splitBySeverity(filtered)
+	if len(errors) == 0 {
+		if len(warnings) == 0 {
+			fmt.Fprintln(os.Stderr, "[check-comments] Success: No problematic comments/docstrings found")
+			os.Exit(exitPass)
+			return
+		}
+		writeHookJSON(warnings, hookInput.HookEventName, false)
+		return
+	}
+	filtered = append(errors, warnings...)
+
+	// PreToolUse always answers with a permission decision so the write is rejected
+	if outputFormat == outputFormatHookJSON || hookInput.HookEventName == output.PreToolUseEventName {
+		writeHookJSON(filtered, hookInput.HookEventName, !nonBlocking)
+		return
+	}
+
 	// Problematic comments found - output warning and exit with code 2
 	message := output.FormatHookMessage(filtered, customPrompt)
 	fmt.Fprint(os.Stderr, message)
 	os.Exit(exitBlock)
 }
 
+// fileExtension returns the extension used for language lookup.
+// Files without an extension (e.g. "Dockerfile") use their lowercased base name.
+func fileExtension(filePath string) string {
+	ext := strings.TrimPrefix(filepath.Ext(filePath), ".")
+	if ext == "" {
+		return strings.ToLower(filepath.Base(filePath))
+	}
+	return ext
+}
+
+// splitBySeverity returns the error and warning findings among comments.
+// Info findings are in neither.
+func splitBySeverity(comments []models.CommentInfo) (errors, warnings []models.CommentInfo) {
+	for _, c := range comments {
+		switch {
+		case c.IsError():
+			errors = append(errors, c)
+		case c.Severity == models.SeverityWarning:
+			warnings = append(warnings, c)
+		}
+	}
+	return errors, warnings
+}
+
+// writeHookJSON prints the structured hook decision to stdout and exits with code 0,
+// leaving blocking semantics to the JSON decision.
+func writeHookJSON(comments []models.CommentInfo, hookEventName string, blocking bool) {
+	result, err := output.FormatHookJSON(comments, customPrompt, hookEventName, blocking)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "[check-comments] Skipping: Failed to encode hook output")
+		os.Exit(exitPass)
+		return
+	}
+	fmt.Fprint(os.Stdout, result)
+	os.Exit(exitPass)
+}
+
+// resolveHookPath returns an absolute path for the hook's file, resolving relative paths against cwd.
+func resolveHookPath(cwd, filePath string) string {
+	if filepath.IsAbs(filePath) || cwd == "" {
+		return filePath
+	}
+	return filepath.Join(cwd, filePath)
+}
+
+// detectComments detects comments in content according to the file's rules.
+// Files in ignored languages yield no comments.
+func detectComments(detector *core.CommentDetector, content, filePath string, rules config.Rules) []models.CommentInfo {
+	langName := core.NewLanguageRegistry().GetLanguageName(fileExtension(filePath))
+	if rules.IgnoresLanguage(langName) {
+		return nil
+	}
+	return detector.Detect(content, filePath, rules.Docstrings)
+}
+
+// readEditedFile reads the hook's target file from disk, or returns an empty string if it cannot be read.
+func readEditedFile(hookInput HookInput) string {
+	return input.ReadFile(resolveHookPath(hookInput.Cwd, hookInput.ToolInput.FilePath))
+}
+
+// editAnchor returns the edit text expected on disk when the hook runs:
+// the replaced old_string before the write (PreToolUse), new_string after it.
+func editAnchor(hookInput HookInput, edit edits.Edit) string {
+	if hookInput.HookEventName == output.PreToolUseEventName {
+		return edit.OldString
+	}
+	return edit.NewString
+}
+
+// toolEdits returns the edit sequence of an Edit or MultiEdit payload.
+func toolEdits(hookInput HookInput) []edits.Edit {
+	if hookInput.ToolName != "MultiEdit" {
+		return []edits.Edit{{
+			OldString:  hookInput.ToolInput.OldString,
+			NewString:  hookInput.ToolInput.NewString,
+			ReplaceAll: hookInput.ToolInput.ReplaceAll,
+		}}
+	}
+
+	sequence := make([]edits.Edit, 0, len(hookInput.ToolInput.Edits))
+	for _, edit := range hookInput.ToolInput.Edits {
+		sequence = append(sequence, edits.Edit{
+			OldString:  edit.OldString,
+			NewString:  edit.NewString,
+			ReplaceAll: edit.ReplaceAll,
+		})
+	}
+	return sequence
+}
+
+// detectEditComments detects comments introduced by a sequence of edits. The
+// full file is reconstructed before and after all edits and both versions are
+// parsed, so fragments are seen in context and a comment added by one edit and
+// removed by a later one is not reported. If the edits cannot be replayed
+// against the file (or reverted after a replace_all), each edit's snippets are
+// parsed on their own and relocated into the file where possible.
+func detectEditComments(detector *core.CommentDetector, hookInput HookInput, sequence []edits.Edit, rules config.Rules) []models.CommentInfo {
+	filePath := hookInput.ToolInput.FilePath
+	applied := hookInput.HookEventName != output.PreToolUseEventName
+	fileContent := readEditedFile(hookInput)
+
+	before, after, ok := edits.Reconstruct(fileContent, sequence, applied)
+	if ok {
+		return filterNewComments(
+			detectComments(detector, before, filePath, rules),
+			detectComments(detector, after, filePath, rules),
+			rules,
+		)
+	}
+
+	var comments []models.CommentInfo
+	for _, edit := range sequence {
+		if edit.NewString == "" {
+			continue
+		}
+		editComments := detectNewCommentsForEdit(detector, edit.OldString, edit.NewString, filePath, rules)
+		if edit.ReplaceAll {
+			comments = append(comments, edits.RelocateAll(editComments, fileContent, editAnchor(hookInput, edit))...)
+			continue
+		}
+		comments = append(comments, edits.Relocate(editComments, fileContent, editAnchor(hookInput, edit))...)
+	}
+	return comments
+}
+
 // getContentToCheck extracts the content to check based on tool type.
 func getContentToCheck(input HookInput) string {
 	switch input.ToolName {
@@ -203,59 +377,80 @@ func getContentToCheck(input HookInput) string {
 	}
 }
 
-// applyFilters applies all filters in order and returns remaining comments.
-func applyFilters(comments []models.CommentInfo) []models.CommentInfo {
-	bddFilter := filters.NewBDDFilter()
-	directiveFilter := filters.NewDirectiveFilter()
-	shebangFilter := filters.NewShebangFilter()
+// applyFilters applies all enabled filters in order and returns remaining comments.
+func applyFilters(comments []models.CommentInfo, rules config.Rules) []models.CommentInfo {
+	flagged, _ := classifyComments(comments, rules)
+	return flagged
+}
+
+// classifyComments splits comments into flagged ones and ones allowed by a filter.
+// Every comment first gets its agent memo verdict and category, and flagged
+// ones get the category's severity.
+// Allowed comments record the allowing filter's name in their metadata.
+// Comments matching a deny pattern are always flagged.
+func classifyComments(comments []models.CommentInfo, rules config.Rules) (flagged, allowed []models.CommentInfo) {
+	registry := newFilterRegistry(rules)
+	agentMemoFilter := filters.NewAgentMemoFilterWithLanguages(rules.AgentMemoLanguages, rules.AgentMemoScriptDetection)
 
-	var filtered []models.CommentInfo
 	for _, c := range comments {
-		if bddFilter.ShouldSkip(c) {
-			continue
-		}
-		if directiveFilter.ShouldSkip(c) {
+		c = agentMemoFilter.Annotate(c)
+		c.Category = filters.Categorize(c)
+		if name, skipped := registry.Match(c); skipped && !filters.MatchesAny(rules.DenyPatterns, c.Text) {
+			allowed = append(allowed, c.WithMetadata(models.MetadataAllowedBy, name))
 			continue
 		}
-		if shebangFilter.ShouldSkip(c) {
-			continue
-		}
-		filtered = append(filtered, c)
+		c.Severity = rules.SeverityFor(c.Category)
+		flagged = append(flagged, c)
 	}
 
-	return filtered
+	return flagged, allowed
 }
 
-// buildCommentTextSet creates a set of normalized comment texts for comparison.
-func buildCommentTextSet(comments []models.CommentInfo) map[string]struct{} {
-	set := make(map[string]struct{}, len(comments))
-	for _, c := range comments {
-		set[c.NormalizedText()] = struct{}{}
+// newFilterRegistry builds the filter chain for a file: the default registry with
+// built-in filters configured from rules, then config and command-line toggles.
+// The public API doc, TODO and non-memo filters are only enabled by the
+// public_api_docs, todos and agent_memos policies.
+func newFilterRegistry(rules config.Rules) *filters.Registry {
+	registry := filters.NewDefaultRegistry()
+	registry.Register(filters.NewBDDFilterWithKeywords(rules.BDDKeywords))
+	registry.Register(filters.NewDirectiveFilterWithPrefixes(rules.DirectivePrefixes))
+	registry.Register(filters.NewPatternFilter(rules.AllowPatterns))
+	registry.Register(filters.NewPublicAPIDocFilter())
+	if rules.PublicAPIDocs != config.PublicAPIDocsAllow {
+		registry.Disable(filters.PublicAPIDocFilterName)
+	}
+	registry.Register(filters.NewTodoFilter(rules.Todos == config.TodosTicket))
+	if rules.Todos == config.TodosFlag {
+		registry.Disable(filters.TodoFilterName)
+	}
+	registry.Register(filters.NewNonMemoFilter())
+	if rules.AgentMemos != config.AgentMemosOnly {
+		registry.Disable(filters.NonMemoFilterName)
 	}
-	return set
-}
 
-// filterNewComments returns comments that exist in newComments but not in oldComments.
-func filterNewComments(oldComments, newComments []models.CommentInfo) []models.CommentInfo {
-	if len(oldComments) == 0 {
-		return newComments
+	for name := range rules.DisabledFilters {
+		registry.Disable(name)
+	}
+	for _, name := range disabledFilters {
+		registry.Disable(name)
+	}
+	for _, name := range enabledFilters {
+		registry.Enable(name)
 	}
 
-	oldSet := buildCommentTextSet(oldComments)
+	return registry
+}
 
-	var newOnly []models.CommentInfo
-	for _, c := range newComments {
-		if _, exists := oldSet[c.NormalizedText()]; !exists {
-			newOnly = append(newOnly, c)
-		}
-	}
-	return newOnly
+// filterNewComments returns the comments of newComments whose change against
+// oldComments (added, modified, moved, unchanged) is flagged by the rules.
+func filterNewComments(oldComments, newComments []models.CommentInfo, rules config.Rules) []models.CommentInfo {
+	return compare.Select(compare.Diff(oldComments, newComments), rules.FlagChanges)
 }
 
 // detectNewCommentsForEdit detects comments that are newly added in Edit operation.
-func detectNewCommentsForEdit(detector *core.CommentDetector, oldString, newString, filePath string) []models.CommentInfo {
-	oldComments := detector.Detect(oldString, filePath, true)
-	newComments := detector.Detect(newString, filePath, true)
+func detectNewCommentsForEdit(detector *core.CommentDetector, oldString, newString, filePath string, rules config.Rules) []models.CommentInfo {
+	oldComments := detectComments(detector, oldString, filePath, rules)
+	newComments := detectComments(detector, newString, filePath, rules)
 
-	return filterNewComments(oldComments, newComments)
+	return filterNewComments(oldComments, newComments, rules)
 }
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/config"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/git"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+func newPreCommitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pre-commit [files...]",
+		Short: "Check the staged content of the given files (pre-commit framework mode)",
+		Long:  "Checks the staged (index) content of each given file and prints findings as file:line: comment. Exits with code 1 when any are found.",
+		Run:   runPreCommit,
+	}
+
+	addReportFormatFlag(cmd)
+	addBaselineFlag(cmd)
+
+	return cmd
+}
+
+func runPreCommit(cmd *cobra.Command, args []string) {
+	validateReportFormat()
+
+	if len(args) == 0 {
+		os.Exit(exitPass)
+		return
+	}
+
+	root, err := git.TopLevel("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+		return
+	}
+
+	registry := core.NewLanguageRegistry()
+	resolver := config.NewResolver()
+	detector := core.NewCommentDetector()
+
+	result := scanResult{baseline: loadBaseline()}
+	for _, filePath := range args {
+		if !registry.IsSupported(fileExtension(filePath)) {
+			continue
+		}
+
+		relPath, err := repoRelativePath(root, filePath)
+		if err != nil {
+			continue
+		}
+
+		// Files missing from the index (e.g. deleted) have nothing to check
+		content, err := git.ShowFile(root, "", relPath)
+		if err != nil {
+			continue
+		}
+
+		rules, err := resolver.RulesFor(filePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[check-comments] Error: Invalid config file: %v\n", err)
+			os.Exit(exitFail)
+			return
+		}
+
+		result.add(filePath, detectComments(detector, content, filePath, rules), rules)
+	}
+
+	writeReport(result, output.FormatCompactReport)
+}
+
+// repoRelativePath converts a path to the slash-separated form git uses for index lookups.
+func repoRelativePath(root, filePath string) (string, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(filepath.Dir(absPath)); err == nil {
+		absPath = filepath.Join(resolved, filepath.Base(absPath))
+	}
+	rel, err := filepath.Rel(root, absPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/baseline"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/config"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/filters"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	reportFormatText  = "text"
+	reportFormatJSON  = "json"
+	reportFormatSARIF = "sarif"
+)
+
+// allowedByBaseline is recorded as allowed_by for comments grandfathered by the baseline.
+const allowedByBaseline = "baseline"
+
+var (
+	reportFormat   string
+	listSuppressed bool
+)
+
+// scanResult accumulates findings across the files checked by scan, diff and pre-commit.
+// Flagged comments found in the baseline, if any, are recorded as allowed.
+type scanResult struct {
+	flagged  []models.CommentInfo
+	allowed  []models.CommentInfo
+	files    int
+	baseline *baseline.Baseline
+}
+
+// add classifies the comments of one checked file and records them.
+// filePath locates the file for baseline matching.
+func (r *scanResult) add(filePath string, comments []models.CommentInfo, rules config.Rules) {
+	flagged, allowed := classifyComments(comments, rules)
+	if r.baseline != nil {
+		var baselined []models.CommentInfo
+		flagged, baselined = r.baseline.Match(filePath, flagged)
+		for _, c := range baselined {
+			allowed = append(allowed, c.WithMetadata(models.MetadataAllowedBy, allowedByBaseline))
+		}
+	}
+	r.flagged = append(r.flagged, flagged...)
+	r.allowed = append(r.allowed, allowed...)
+	r.files++
+}
+
+// suppressed returns the allowed comments that were suppressed by pragmas.
+func (r *scanResult) suppressed() []models.CommentInfo {
+	var suppressed []models.CommentInfo
+	for _, c := range r.allowed {
+		if c.Metadata[models.MetadataAllowedBy] == filters.PragmaFilterName {
+			suppressed = append(suppressed, c)
+		}
+	}
+	return suppressed
+}
+
+// hasErrors returns true if any flagged comment is an error-severity finding.
+func (r *scanResult) hasErrors() bool {
+	for _, c := range r.flagged {
+		if c.IsError() {
+			return true
+		}
+	}
+	return false
+}
+
+// addReportFormatFlag registers the --format flag on a reporting subcommand.
+func addReportFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&reportFormat, "format", reportFormatText, "Report format: text, json or sarif")
+	cmd.Flags().BoolVar(&listSuppressed, "list-suppressed", false, "In text format, also list comments suppressed by comment-checker pragmas")
+}
+
+// validateReportFormat exits with an error for unknown --format values.
+func validateReportFormat() {
+	switch reportFormat {
+	case reportFormatText, reportFormatJSON, reportFormatSARIF:
+		return
+	}
+	fmt.Fprintf(os.Stderr, "[check-comments] Error: Unknown format %q\n", reportFormat)
+	os.Exit(exitFail)
+}
+
+// writeReport prints the result in the selected format and exits with exitFail if
+// any error-severity finding was flagged.
+// formatText renders findings for the text format.
+func writeReport(result scanResult, formatText func([]models.CommentInfo) string) {
+	switch reportFormat {
+	case reportFormatJSON, reportFormatSARIF:
+		report, err := formatStructuredReport(result)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+			os.Exit(exitFail)
+			return
+		}
+		fmt.Fprint(os.Stdout, report)
+	default:
+		if len(result.flagged) > 0 {
+			fmt.Fprint(os.Stdout, formatText(result.flagged))
+		}
+		if listSuppressed {
+			fmt.Fprint(os.Stdout, output.FormatSuppressionReport(result.suppressed()))
+		}
+	}
+
+	if result.hasErrors() {
+		os.Exit(exitFail)
+		return
+	}
+	os.Exit(exitPass)
+}
+
+// formatStructuredReport renders the result as a JSON or SARIF document.
+func formatStructuredReport(result scanResult) (string, error) {
+	if reportFormat == reportFormatSARIF {
+		return output.FormatSARIF(append(result.flagged, result.suppressed()...), version)
+	}
+	return output.FormatJSONReport(result.flagged, result.allowed, result.files)
+}
+
+// reportFindings writes the per-file scan report, announcing success in text mode.
+func reportFindings(result scanResult) {
+	if len(result.flagged) == 0 && reportFormat == reportFormatText {
+		fmt.Fprintf(os.Stderr, "[check-comments] Success: No problematic comments/docstrings found in %d file(s)\n", result.files)
+	}
+	writeReport(result, output.FormatScanReport)
+}
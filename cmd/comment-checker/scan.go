@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/baseline"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/config"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/input"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+	"github.com/spf13/cobra"
+)
+
+// skippedDirs contains directory names that are never descended into while scanning.
+var skippedDirs = map[string]struct{}{
+	"node_modules": {},
+	"vendor":       {},
+}
+
+func newScanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan [paths...]",
+		Short: "Scan files and directories for problematic comments",
+		Long:  "Walks the given files and directories (default: current directory) and reports problematic comments in every supported source file. Exits with code 1 when any are found.",
+		Run:   runScan,
+	}
+
+	addReportFormatFlag(cmd)
+	cmd.Flags().BoolVar(&scanFix, "fix", false, "Remove problematic comments and report only those that could not be removed")
+	addDryRunFlag(cmd)
+	addBaselineFlag(cmd)
+
+	return cmd
+}
+
+func runScan(cmd *cobra.Command, args []string) {
+	validateReportFormat()
+	if fixDryRun && !scanFix {
+		fmt.Fprintln(os.Stderr, "[check-comments] Error: --dry-run requires --fix")
+		os.Exit(exitFail)
+		return
+	}
+	if fixDryRun && reportFormat != reportFormatText {
+		fmt.Fprintln(os.Stderr, "[check-comments] Error: --dry-run requires --format text")
+		os.Exit(exitFail)
+		return
+	}
+
+	var session *fixSession
+	if scanFix {
+		session = newFixSession()
+	}
+
+	b := loadBaseline()
+	result := checkFiles(args, session, b)
+	if session != nil {
+		session.printSummary()
+	}
+	reportStaleBaseline(b)
+	reportFindings(result)
+}
+
+// checkFiles detects comments in every supported file under paths (default: current
+// directory). When session is non-nil, flagged comments are removed first.
+// When b is non-nil, comments it records are kept and reported as allowed.
+func checkFiles(paths []string, session *fixSession, b *baseline.Baseline) scanResult {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files, err := collectFiles(paths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[check-comments] Error: %v\n", err)
+		os.Exit(exitFail)
+	}
+
+	resolver := config.NewResolver()
+	detector := core.NewCommentDetector()
+	result := scanResult{baseline: b}
+	for _, filePath := range files {
+		rules, err := resolver.RulesFor(filePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[check-comments] Error: Invalid config file: %v\n", err)
+			os.Exit(exitFail)
+		}
+
+		comments := detectFile(detector, filePath, rules)
+		if session != nil {
+			// Warnings and info are not enforced, so only errors are removed
+			flagged, _ := classifyComments(comments, rules)
+			flagged, _ = splitBySeverity(flagged)
+			if b != nil {
+				flagged, _ = b.Peek(filePath, flagged)
+			}
+			comments = session.fixFile(detector, filePath, comments, flagged, rules)
+		}
+		result.add(filePath, comments, rules)
+	}
+
+	return result
+}
+
+// collectFiles expands the given paths into the list of supported source files.
+// Directories are walked recursively, skipping hidden and dependency directories.
+func collectFiles(paths []string) ([]string, error) {
+	registry := core.NewLanguageRegistry()
+
+	var files []string
+	for _, root := range paths {
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			if registry.IsSupported(fileExtension(root)) {
+				files = append(files, root)
+			}
+			continue
+		}
+
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != root && isSkippedDir(d.Name()) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Type().IsRegular() && registry.IsSupported(fileExtension(path)) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// isSkippedDir returns true if a directory should not be scanned.
+func isSkippedDir(name string) bool {
+	if len(name) > 1 && name[0] == '.' {
+		return true
+	}
+	_, skipped := skippedDirs[name]
+	return skipped
+}
+
+// detectFile reads a file from disk and returns all of its comments.
+func detectFile(detector *core.CommentDetector, filePath string, rules config.Rules) []models.CommentInfo {
+	content := input.ReadFile(filePath)
+	if content == "" {
+		return nil
+	}
+	return detectComments(detector, content, filePath, rules)
+}
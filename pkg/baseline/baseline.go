@@ -0,0 +1,237 @@
+// Package baseline records existing comments so that checks only report new ones.
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// FileName is the default name of the baseline file.
+const FileName = ".comment-checker-baseline.json"
+
+// Version is the schema version of the baseline file.
+const Version = 1
+
+// Entry is one fingerprint in the baseline: how many comments with the same
+// normalized text the file had in the same enclosing declaration.
+type Entry struct {
+	// File is the slash-separated path relative to the baseline file's directory.
+	File string `json:"file"`
+	// Scope names the enclosing declarations, e.g. "Greeter.hi"; empty at top level.
+	Scope string `json:"scope,omitempty"`
+	// Text is the normalized comment text.
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+// fingerprint identifies comments that are considered the same across edits.
+type fingerprint struct {
+	file, scope, text string
+}
+
+// Baseline is a set of grandfathered comments. Matching consumes entries, so
+// a file with more copies of a comment than were recorded reports the extras.
+type Baseline struct {
+	dir       string
+	counts    map[fingerprint]int
+	remaining map[fingerprint]int
+	checked   map[string]struct{}
+}
+
+type file struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+// New creates an empty baseline whose paths are relative to dir.
+func New(dir string) *Baseline {
+	return &Baseline{
+		dir:       dir,
+		counts:    make(map[fingerprint]int),
+		remaining: make(map[fingerprint]int),
+		checked:   make(map[string]struct{}),
+	}
+}
+
+// Load reads the baseline file at path.
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if f.Version != Version {
+		return nil, fmt.Errorf("%s: unsupported baseline version %d", path, f.Version)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b := New(filepath.Dir(absPath))
+	for _, entry := range f.Entries {
+		key := fingerprint{file: entry.File, scope: entry.Scope, text: entry.Text}
+		b.counts[key] += entry.Count
+		b.remaining[key] += entry.Count
+	}
+	return b, nil
+}
+
+// Find walks up from startDir and returns the path of the nearest baseline file.
+// Returns empty string if none exists.
+func Find(startDir string) string {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return ""
+	}
+
+	for {
+		candidate := filepath.Join(dir, FileName)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// Add records a comment found in filePath.
+func (b *Baseline) Add(filePath string, comment models.CommentInfo) {
+	key := b.fingerprint(filePath, comment)
+	b.counts[key]++
+	b.remaining[key]++
+}
+
+// Len returns the number of comments recorded in the baseline.
+func (b *Baseline) Len() int {
+	total := 0
+	for _, count := range b.counts {
+		total += count
+	}
+	return total
+}
+
+// Entries returns the baseline entries sorted by file, scope and text.
+func (b *Baseline) Entries() []Entry {
+	return sortedEntries(b.counts)
+}
+
+// Save writes the baseline to path as indented JSON.
+func (b *Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(file{Version: Version, Entries: b.Entries()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Match splits the comments of filePath into those not in the baseline and
+// those it grandfathers, consuming one baseline entry per grandfathered comment.
+func (b *Baseline) Match(filePath string, comments []models.CommentInfo) (kept, baselined []models.CommentInfo) {
+	b.checked[b.relativePath(filePath)] = struct{}{}
+
+	kept, baselined, used := b.split(filePath, comments)
+	for key, n := range used {
+		b.remaining[key] -= n
+	}
+	return kept, baselined
+}
+
+// Peek splits the comments like Match without consuming any entries, so a
+// later Match of the same file sees the baseline unchanged.
+func (b *Baseline) Peek(filePath string, comments []models.CommentInfo) (kept, baselined []models.CommentInfo) {
+	kept, baselined, _ = b.split(filePath, comments)
+	return kept, baselined
+}
+
+// split partitions the comments against the remaining entries and returns how
+// many of each entry the grandfathered comments use.
+func (b *Baseline) split(filePath string, comments []models.CommentInfo) (kept, baselined []models.CommentInfo, used map[fingerprint]int) {
+	used = make(map[fingerprint]int)
+	for _, c := range comments {
+		key := b.fingerprint(filePath, c)
+		if b.remaining[key] > used[key] {
+			used[key]++
+			baselined = append(baselined, c)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept, baselined, used
+}
+
+// Stale returns the entries no longer matched by any comment: entries of files
+// checked by Match whose comments are gone, and entries of deleted files.
+// Count is the number of unmatched comments.
+func (b *Baseline) Stale() []Entry {
+	stale := make(map[fingerprint]int)
+	for key, count := range b.remaining {
+		if count == 0 {
+			continue
+		}
+		if _, checked := b.checked[key.file]; checked || !b.exists(key.file) {
+			stale[key] = count
+		}
+	}
+	return sortedEntries(stale)
+}
+
+// fingerprint returns the key for a comment: its file, enclosing scope and
+// normalized text with whitespace runs collapsed.
+func (b *Baseline) fingerprint(filePath string, comment models.CommentInfo) fingerprint {
+	return fingerprint{
+		file:  b.relativePath(filePath),
+		scope: comment.Metadata[models.MetadataScope],
+		text:  strings.Join(strings.Fields(comment.NormalizedText()), " "),
+	}
+}
+
+// relativePath returns filePath relative to the baseline directory, slash-separated.
+func (b *Baseline) relativePath(filePath string) string {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return filepath.ToSlash(filePath)
+	}
+	rel, err := filepath.Rel(b.dir, absPath)
+	if err != nil {
+		return filepath.ToSlash(filePath)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// exists returns true if a baseline-relative file is still on disk.
+func (b *Baseline) exists(relPath string) bool {
+	_, err := os.Stat(filepath.Join(b.dir, filepath.FromSlash(relPath)))
+	return err == nil
+}
+
+func sortedEntries(counts map[fingerprint]int) []Entry {
+	entries := make([]Entry, 0, len(counts))
+	for key, count := range counts {
+		entries = append(entries, Entry{File: key.file, Scope: key.scope, Text: key.text, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].File != entries[j].File {
+			return entries[i].File < entries[j].File
+		}
+		if entries[i].Scope != entries[j].Scope {
+			return entries[i].Scope < entries[j].Scope
+		}
+		return entries[i].Text < entries[j].Text
+	})
+	return entries
+}
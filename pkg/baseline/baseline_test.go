@@ -0,0 +1,140 @@
+package baseline
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func comment(text, scope string) models.CommentInfo {
+	c := models.CommentInfo{Text: text, Metadata: map[string]string{}}
+	if scope != "" {
+		c.Metadata[models.MetadataScope] = scope
+	}
+	return c
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func Test_SaveAndLoad_RoundTripsEntries(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	b := New(dir)
+	b.Add(filepath.Join(dir, "src", "a.go"), comment("// Old  Note", "Run"))
+	b.Add(filepath.Join(dir, "src", "a.go"), comment("// old note", "Run"))
+	b.Add(filepath.Join(dir, "b.py"), comment("# legacy", ""))
+	path := filepath.Join(dir, FileName)
+
+	// when
+	require.NoError(t, b.Save(path))
+	loaded, err := Load(path)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []Entry{
+		{File: "b.py", Text: "# legacy", Count: 1},
+		{File: "src/a.go", Scope: "Run", Text: "// old note", Count: 2},
+	}, loaded.Entries())
+	assert.Equal(t, 3, loaded.Len())
+}
+
+func Test_Load_UnknownVersion_ReturnsError(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), FileName)
+	writeFile(t, path, `{"version": 99, "entries": []}`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, "unsupported baseline version 99")
+}
+
+func Test_Match_ConsumesEntriesPerOccurrence(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "a.go")
+	b := New(dir)
+	b.Add(filePath, comment("// legacy", "Run"))
+	comments := []models.CommentInfo{
+		comment("// legacy", "Run"),
+		comment("// legacy", "Run"),
+		comment("// legacy", "Stop"),
+	}
+
+	// when
+	kept, baselined := b.Match(filePath, comments)
+
+	// then
+	assert.Len(t, baselined, 1)
+	assert.Len(t, kept, 2)
+	assert.Equal(t, "Stop", kept[1].Metadata[models.MetadataScope])
+}
+
+func Test_Peek_DoesNotConsumeEntries(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "a.go")
+	writeFile(t, filePath, "package a\n")
+	b := New(dir)
+	b.Add(filePath, comment("// legacy", "Run"))
+	comments := []models.CommentInfo{comment("// legacy", "Run"), comment("// legacy", "Run")}
+
+	// when
+	peekKept, peekBaselined := b.Peek(filePath, comments)
+	kept, baselined := b.Match(filePath, comments)
+
+	// then
+	assert.Len(t, peekBaselined, 1)
+	assert.Len(t, peekKept, 1)
+	assert.Len(t, baselined, 1)
+	assert.Len(t, kept, 1)
+	assert.Empty(t, b.Stale())
+}
+
+func Test_Stale_ReportsDeletedCommentsOfCheckedAndMissingFiles(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	checked := filepath.Join(dir, "a.go")
+	unchecked := filepath.Join(dir, "b.go")
+	writeFile(t, checked, "package a\n")
+	writeFile(t, unchecked, "package b\n")
+	b := New(dir)
+	b.Add(checked, comment("// kept", ""))
+	b.Add(checked, comment("// deleted", ""))
+	b.Add(unchecked, comment("// not scanned", ""))
+	b.Add(filepath.Join(dir, "gone.go"), comment("// file removed", ""))
+
+	// when
+	b.Match(checked, []models.CommentInfo{comment("// kept", "")})
+	stale := b.Stale()
+
+	// then
+	assert.Equal(t, []Entry{
+		{File: "a.go", Text: "// deleted", Count: 1},
+		{File: "gone.go", Text: "// file removed", Count: 1},
+	}, stale)
+}
+
+func Test_Find_BaselineInParentDirectory_ReturnsPath(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, FileName), `{"version": 1, "entries": []}`)
+	sub := filepath.Join(dir, "pkg", "deep")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+
+	// when
+	path := Find(sub)
+
+	// then
+	assert.Equal(t, filepath.Join(dir, FileName), path)
+}
@@ -0,0 +1,233 @@
+// Package compare aligns the comments of two versions of a file.
+package compare
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// Label describes how a comment in the new version relates to the old version.
+type Label string
+
+const (
+	// LabelAdded marks a comment with no counterpart in the old version.
+	LabelAdded Label = "added"
+	// LabelUnchanged marks a comment kept in the same relative position.
+	LabelUnchanged Label = "unchanged"
+	// LabelMoved marks a comment whose text exists in the old version at another position.
+	LabelMoved Label = "moved"
+	// LabelModified marks a reworded version of an old comment at the same position.
+	LabelModified Label = "modified"
+)
+
+// Labels lists every label in the order they are documented.
+var Labels = []Label{LabelAdded, LabelUnchanged, LabelMoved, LabelModified}
+
+// minModifiedSimilarity is the word overlap required for a replaced comment to
+// count as a rewording of the old one rather than a new comment.
+const minModifiedSimilarity = 0.5
+
+// Change is a comment of the new version with its label.
+// Old is the matching comment of the old version, if any.
+type Change struct {
+	Comment models.CommentInfo
+	Label   Label
+	Old     *models.CommentInfo
+}
+
+// Diff labels every comment of newComments against oldComments.
+// Comments are compared in source order by normalized text: the longest common
+// subsequence is unchanged, remaining texts still present in the old version
+// (counting duplicates) are moved, and remaining comments that replace a
+// similar old comment between the same unchanged neighbours are modified.
+// Changes are returned in source order.
+func Diff(oldComments, newComments []models.CommentInfo) []Change {
+	oldSorted := sortedByPosition(oldComments)
+	newSorted := sortedByPosition(newComments)
+
+	oldKeys := keys(oldSorted)
+	newKeys := keys(newSorted)
+	oldMatch, newMatch := lcs(oldKeys, newKeys)
+	oldGaps, newGaps := gapIndexes(oldMatch, newMatch)
+
+	changes := make([]Change, len(newSorted))
+	for j, c := range newSorted {
+		changes[j].Comment = c
+		if i := newMatch[j]; i >= 0 {
+			changes[j].Label = LabelUnchanged
+			changes[j].Old = &oldSorted[i]
+		}
+	}
+
+	// Texts that survive elsewhere in the file are moves, one per old occurrence
+	unmatchedOld := make(map[string][]int)
+	for i, key := range oldKeys {
+		if oldMatch[i] < 0 {
+			unmatchedOld[key] = append(unmatchedOld[key], i)
+		}
+	}
+	for j := range changes {
+		if changes[j].Label != "" {
+			continue
+		}
+		if candidates := unmatchedOld[newKeys[j]]; len(candidates) > 0 {
+			i := candidates[0]
+			unmatchedOld[newKeys[j]] = candidates[1:]
+			oldMatch[i] = j
+			changes[j].Label = LabelMoved
+			changes[j].Old = &oldSorted[i]
+		}
+	}
+
+	// Pair the rest with similar old comments in the same gap between unchanged ones
+	for j := range changes {
+		if changes[j].Label != "" {
+			continue
+		}
+		changes[j].Label = LabelAdded
+
+		best, bestScore := -1, 0.0
+		for i := range oldSorted {
+			if oldMatch[i] >= 0 || oldGaps[i] != newGaps[j] {
+				continue
+			}
+			score := similarity(oldKeys[i], newKeys[j])
+			if score >= minModifiedSimilarity && score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best >= 0 {
+			oldMatch[best] = j
+			changes[j].Label = LabelModified
+			changes[j].Old = &oldSorted[best]
+		}
+	}
+
+	return changes
+}
+
+// Select returns the comments whose label is in labels.
+func Select(changes []Change, labels map[Label]struct{}) []models.CommentInfo {
+	var selected []models.CommentInfo
+	for _, change := range changes {
+		if _, ok := labels[change.Label]; ok {
+			selected = append(selected, change.Comment)
+		}
+	}
+	return selected
+}
+
+// sortedByPosition returns a copy of comments ordered by their start offset.
+func sortedByPosition(comments []models.CommentInfo) []models.CommentInfo {
+	sorted := make([]models.CommentInfo, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].LineNumber != sorted[j].LineNumber {
+			return sorted[i].LineNumber < sorted[j].LineNumber
+		}
+		return sorted[i].StartByte < sorted[j].StartByte
+	})
+	return sorted
+}
+
+func keys(comments []models.CommentInfo) []string {
+	result := make([]string, len(comments))
+	for i := range comments {
+		result[i] = comments[i].NormalizedText()
+	}
+	return result
+}
+
+// lcs aligns a and b by their longest common subsequence. It returns, for each
+// element, the index of its partner in the other slice or -1.
+func lcs(a, b []string) (aMatch, bMatch []int) {
+	n, m := len(a), len(b)
+	lengths := make([][]int, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	aMatch = filled(n, -1)
+	bMatch = filled(m, -1)
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case a[i] == b[j]:
+			aMatch[i], bMatch[j] = j, i
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return aMatch, bMatch
+}
+
+// gapIndexes numbers the stretches between aligned pairs. For each element it
+// returns how many aligned pairs precede it on its own side.
+func gapIndexes(aMatch, bMatch []int) (aGaps, bGaps []int) {
+	return precedingMatches(aMatch), precedingMatches(bMatch)
+}
+
+func precedingMatches(match []int) []int {
+	result := make([]int, len(match))
+	count := 0
+	for k, partner := range match {
+		result[k] = count
+		if partner >= 0 {
+			count++
+		}
+	}
+	return result
+}
+
+// similarity returns the Dice coefficient of the word sets of a and b.
+func similarity(a, b string) float64 {
+	wordsA := words(a)
+	wordsB := words(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for w := range wordsA {
+		if _, ok := wordsB[w]; ok {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(wordsA)+len(wordsB))
+}
+
+// words splits text into its set of alphanumeric words.
+func words(text string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, w := range strings.FieldsFunc(text, isSeparator) {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+func isSeparator(r rune) bool {
+	return !(r == '_' || r >= 0x80 ||
+		('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9'))
+}
+
+func filled(n, value int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = value
+	}
+	return result
+}
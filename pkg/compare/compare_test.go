@@ -0,0 +1,114 @@
+package compare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func comment(line int, text string) models.CommentInfo {
+	return models.CommentInfo{Text: text, LineNumber: line}
+}
+
+func labels(changes []Change) []Label {
+	result := make([]Label, len(changes))
+	for i, change := range changes {
+		result[i] = change.Label
+	}
+	return result
+}
+
+func Test_Diff_NoOldComments_AllAdded(t *testing.T) {
+	// given
+	newComments := []models.CommentInfo{comment(1, "// a"), comment(2, "// b")}
+
+	// when
+	changes := Diff(nil, newComments)
+
+	// then
+	assert.Equal(t, []Label{LabelAdded, LabelAdded}, labels(changes))
+}
+
+func Test_Diff_DuplicateOfExistingText_SecondCopyIsAdded(t *testing.T) {
+	// given
+	oldComments := []models.CommentInfo{comment(1, "// TODO")}
+	newComments := []models.CommentInfo{comment(1, "// TODO"), comment(5, "// TODO")}
+
+	// when
+	changes := Diff(oldComments, newComments)
+
+	// then
+	assert.Equal(t, []Label{LabelUnchanged, LabelAdded}, labels(changes))
+}
+
+func Test_Diff_ReorderedComment_IsMoved(t *testing.T) {
+	// given
+	oldComments := []models.CommentInfo{comment(1, "// first"), comment(3, "// second"), comment(5, "// third")}
+	newComments := []models.CommentInfo{comment(1, "// third"), comment(3, "// first"), comment(5, "// second")}
+
+	// when
+	changes := Diff(oldComments, newComments)
+
+	// then
+	assert.Equal(t, []Label{LabelMoved, LabelUnchanged, LabelUnchanged}, labels(changes))
+	require.NotNil(t, changes[0].Old)
+	assert.Equal(t, 5, changes[0].Old.LineNumber)
+}
+
+func Test_Diff_RewordedCommentInPlace_IsModified(t *testing.T) {
+	// given
+	oldComments := []models.CommentInfo{comment(1, "// keep"), comment(3, "// compute the total price"), comment(9, "// end")}
+	newComments := []models.CommentInfo{comment(1, "// keep"), comment(3, "// compute the final total price"), comment(9, "// end")}
+
+	// when
+	changes := Diff(oldComments, newComments)
+
+	// then
+	assert.Equal(t, []Label{LabelUnchanged, LabelModified, LabelUnchanged}, labels(changes))
+	require.NotNil(t, changes[1].Old)
+	assert.Equal(t, "// compute the total price", changes[1].Old.Text)
+}
+
+func Test_Diff_UnrelatedReplacement_IsAdded(t *testing.T) {
+	// given
+	oldComments := []models.CommentInfo{comment(3, "// compute the total price")}
+	newComments := []models.CommentInfo{comment(3, "// changed this to use a map")}
+
+	// when
+	changes := Diff(oldComments, newComments)
+
+	// then
+	assert.Equal(t, []Label{LabelAdded}, labels(changes))
+}
+
+func Test_Diff_SimilarCommentAcrossUnchangedAnchor_IsAdded(t *testing.T) {
+	// given
+	oldComments := []models.CommentInfo{comment(1, "// compute the total price"), comment(5, "// anchor")}
+	newComments := []models.CommentInfo{comment(5, "// anchor"), comment(9, "// compute the total price again")}
+
+	// when
+	changes := Diff(oldComments, newComments)
+
+	// then
+	assert.Equal(t, []Label{LabelUnchanged, LabelAdded}, labels(changes))
+}
+
+func Test_Select_ReturnsCommentsWithRequestedLabels(t *testing.T) {
+	// given
+	changes := []Change{
+		{Comment: comment(1, "// a"), Label: LabelAdded},
+		{Comment: comment(2, "// b"), Label: LabelMoved},
+		{Comment: comment(3, "// c"), Label: LabelModified},
+	}
+
+	// when
+	selected := Select(changes, map[Label]struct{}{LabelAdded: {}, LabelModified: {}})
+
+	// then
+	require.Len(t, selected, 2)
+	assert.Equal(t, "// a", selected[0].Text)
+	assert.Equal(t, "// c", selected[1].Text)
+}
@@ -0,0 +1,447 @@
+// Package config loads project configuration from .comment-checker.yaml files.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/compare"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/filters"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// FileName is the name of the project configuration file.
+const FileName = ".comment-checker.yaml"
+
+// ListPatch adds entries to and removes entries from a built-in list.
+type ListPatch struct {
+	Add    []string `yaml:"add"`
+	Remove []string `yaml:"remove"`
+}
+
+// Toggle enables and disables named items.
+type Toggle struct {
+	Enable  []string `yaml:"enable"`
+	Disable []string `yaml:"disable"`
+}
+
+// RuleSet holds the configurable rules shared by the top level and overrides.
+type RuleSet struct {
+	Docstrings               *bool             `yaml:"docstrings"`
+	BDDKeywords              ListPatch         `yaml:"bdd_keywords"`
+	Directives               ListPatch         `yaml:"directives"`
+	AllowPatterns            []string          `yaml:"allow_patterns"`
+	DenyPatterns             []string          `yaml:"deny_patterns"`
+	IgnoreLanguages          []string          `yaml:"ignore_languages"`
+	Filters                  Toggle            `yaml:"filters"`
+	FlagChanges              []string          `yaml:"flag_changes"`
+	PublicAPIDocs            string            `yaml:"public_api_docs"`
+	Todos                    string            `yaml:"todos"`
+	AgentMemos               string            `yaml:"agent_memos"`
+	AgentMemoLanguages       ListPatch         `yaml:"agent_memo_languages"`
+	AgentMemoScriptDetection *bool             `yaml:"agent_memo_script_detection"`
+	Severity                 map[string]string `yaml:"severity"`
+
+	allow []*regexp.Regexp
+	deny  []*regexp.Regexp
+}
+
+// Override applies a RuleSet to files matching any of its glob patterns.
+// Patterns without a slash match the file name; others match the path
+// relative to the configuration file. "**" matches any number of directories.
+type Override struct {
+	Files   []string `yaml:"files"`
+	RuleSet `yaml:",inline"`
+}
+
+// Config is a parsed .comment-checker.yaml file.
+type Config struct {
+	RuleSet   `yaml:",inline"`
+	Overrides []Override `yaml:"overrides"`
+
+	dir string
+}
+
+// Rules are the effective settings for a single file.
+type Rules struct {
+	Docstrings               bool
+	BDDKeywords              map[string]struct{}
+	DirectivePrefixes        []string
+	AllowPatterns            []*regexp.Regexp
+	DenyPatterns             []*regexp.Regexp
+	IgnoredLanguages         map[string]struct{}
+	DisabledFilters          map[string]struct{}
+	FlagChanges              map[compare.Label]struct{}
+	PublicAPIDocs            string
+	Todos                    string
+	AgentMemos               string
+	AgentMemoLanguages       map[string]struct{}
+	AgentMemoScriptDetection bool
+	Severities               map[models.Category]models.Severity
+}
+
+// Policies for doc comments on public API declarations (public_api_docs).
+const (
+	// PublicAPIDocsFlag reports public API docs like any other doc comment.
+	PublicAPIDocsFlag = "flag"
+	// PublicAPIDocsAllow allows doc comments on public API declarations.
+	PublicAPIDocsAllow = "allow"
+)
+
+// Policies for TODO, FIXME, HACK, XXX and BUG comments (todos).
+const (
+	// TodosFlag reports every TODO like any other comment.
+	TodosFlag = "flag"
+	// TodosTracked allows TODOs that reference a ticket or name an owner.
+	TodosTracked = "tracked"
+	// TodosTicket allows only TODOs that reference a ticket.
+	TodosTicket = "ticket"
+)
+
+// Policies for memo-style comments such as "Changed from X to Y" (agent_memos).
+const (
+	// AgentMemosFlag reports agent memos along with every other comment.
+	AgentMemosFlag = "flag"
+	// AgentMemosOnly reports agent memos and allows all other comments.
+	AgentMemosOnly = "only"
+)
+
+// DefaultFlagChanges are the edit change labels reported when flag_changes is not set.
+var DefaultFlagChanges = []compare.Label{compare.LabelAdded, compare.LabelModified}
+
+// Default returns the configuration used when no file is found.
+func Default() *Config {
+	return &Config{}
+}
+
+// Load reads and validates the configuration file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := cfg.RuleSet.compile(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for i := range cfg.Overrides {
+		if len(cfg.Overrides[i].Files) == 0 {
+			return nil, fmt.Errorf("%s: override %d has no files", path, i+1)
+		}
+		if err := cfg.Overrides[i].RuleSet.compile(); err != nil {
+			return nil, fmt.Errorf("%s: override %d: %w", path, i+1, err)
+		}
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg.dir = filepath.Dir(absPath)
+
+	return cfg, nil
+}
+
+// Find walks up from startDir and returns the path of the nearest configuration file.
+// Returns empty string if none exists.
+func Find(startDir string) string {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return ""
+	}
+
+	for {
+		candidate := filepath.Join(dir, FileName)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// Discover loads the nearest configuration file above startDir,
+// falling back to Default when there is none.
+func Discover(startDir string) (*Config, error) {
+	path := Find(startDir)
+	if path == "" {
+		return Default(), nil
+	}
+	return Load(path)
+}
+
+// RulesFor returns the effective rules for filePath, applying matching overrides in order.
+func (c *Config) RulesFor(filePath string) Rules {
+	rules := Rules{
+		Docstrings:         true,
+		BDDKeywords:        make(map[string]struct{}, len(filters.BDDKeywords)),
+		DirectivePrefixes:  append([]string(nil), filters.TypeCheckerPrefixes...),
+		IgnoredLanguages:   make(map[string]struct{}),
+		DisabledFilters:    make(map[string]struct{}),
+		FlagChanges:        make(map[compare.Label]struct{}, len(DefaultFlagChanges)),
+		PublicAPIDocs:      PublicAPIDocsFlag,
+		Todos:              TodosFlag,
+		AgentMemos:         AgentMemosFlag,
+		AgentMemoLanguages: make(map[string]struct{}, len(filters.DefaultAgentMemoLanguages)),
+		Severities:         make(map[models.Category]models.Severity, len(models.Categories)),
+	}
+	for _, category := range models.Categories {
+		rules.Severities[category] = models.SeverityError
+	}
+	for category, severity := range defaultSeverities {
+		rules.Severities[category] = severity
+	}
+	for _, label := range DefaultFlagChanges {
+		rules.FlagChanges[label] = struct{}{}
+	}
+	for keyword := range filters.BDDKeywords {
+		rules.BDDKeywords[keyword] = struct{}{}
+	}
+	for lang := range filters.DefaultAgentMemoLanguages {
+		rules.AgentMemoLanguages[lang] = struct{}{}
+	}
+
+	rules.apply(c.RuleSet)
+
+	relPath := c.relativePath(filePath)
+	for _, override := range c.Overrides {
+		if override.matches(relPath) {
+			rules.apply(override.RuleSet)
+		}
+	}
+
+	return rules
+}
+
+// IgnoresLanguage returns true if comments in the given language are not checked.
+func (r Rules) IgnoresLanguage(langName string) bool {
+	_, ignored := r.IgnoredLanguages[langName]
+	return ignored
+}
+
+// defaultSeverities lists the categories that are not errors by default.
+// License headers are usually required, so they are only listed in reports.
+var defaultSeverities = map[models.Category]models.Severity{
+	models.CategoryLicense: models.SeverityInfo,
+}
+
+// SeverityFor returns the severity of findings in the given category.
+func (r Rules) SeverityFor(category models.Category) models.Severity {
+	if severity, ok := r.Severities[category]; ok {
+		return severity
+	}
+	return models.SeverityError
+}
+
+// apply layers a RuleSet on top of the current rules.
+func (r *Rules) apply(set RuleSet) {
+	if set.Docstrings != nil {
+		r.Docstrings = *set.Docstrings
+	}
+
+	for _, keyword := range set.BDDKeywords.Add {
+		r.BDDKeywords[normalize(keyword)] = struct{}{}
+	}
+	for _, keyword := range set.BDDKeywords.Remove {
+		delete(r.BDDKeywords, normalize(keyword))
+	}
+
+	for _, prefix := range set.Directives.Add {
+		r.DirectivePrefixes = append(r.DirectivePrefixes, normalize(prefix))
+	}
+	for _, prefix := range set.Directives.Remove {
+		r.DirectivePrefixes = removeString(r.DirectivePrefixes, normalize(prefix))
+	}
+
+	r.AllowPatterns = append(r.AllowPatterns, set.allow...)
+	r.DenyPatterns = append(r.DenyPatterns, set.deny...)
+
+	for _, lang := range set.IgnoreLanguages {
+		r.IgnoredLanguages[normalize(lang)] = struct{}{}
+	}
+
+	for _, name := range set.Filters.Disable {
+		r.DisabledFilters[normalize(name)] = struct{}{}
+	}
+	for _, name := range set.Filters.Enable {
+		delete(r.DisabledFilters, normalize(name))
+	}
+
+	if set.PublicAPIDocs != "" {
+		r.PublicAPIDocs = normalize(set.PublicAPIDocs)
+	}
+
+	if set.Todos != "" {
+		r.Todos = normalize(set.Todos)
+	}
+
+	if set.AgentMemos != "" {
+		r.AgentMemos = normalize(set.AgentMemos)
+	}
+	for _, lang := range set.AgentMemoLanguages.Add {
+		r.AgentMemoLanguages[normalize(lang)] = struct{}{}
+	}
+	for _, lang := range set.AgentMemoLanguages.Remove {
+		delete(r.AgentMemoLanguages, normalize(lang))
+	}
+	if set.AgentMemoScriptDetection != nil {
+		r.AgentMemoScriptDetection = *set.AgentMemoScriptDetection
+	}
+
+	for category, severity := range set.Severity {
+		r.Severities[models.Category(normalize(category))] = models.Severity(normalize(severity))
+	}
+
+	if set.FlagChanges != nil {
+		r.FlagChanges = make(map[compare.Label]struct{}, len(set.FlagChanges))
+		for _, label := range set.FlagChanges {
+			r.FlagChanges[compare.Label(normalize(label))] = struct{}{}
+		}
+	}
+}
+
+// compile compiles the allow and deny regular expressions and validates the policies.
+func (s *RuleSet) compile() error {
+	var err error
+	if s.allow, err = compilePatterns(s.AllowPatterns); err != nil {
+		return fmt.Errorf("allow_patterns: %w", err)
+	}
+	if s.deny, err = compilePatterns(s.DenyPatterns); err != nil {
+		return fmt.Errorf("deny_patterns: %w", err)
+	}
+	switch normalize(s.PublicAPIDocs) {
+	case "", PublicAPIDocsFlag, PublicAPIDocsAllow:
+	default:
+		return fmt.Errorf("public_api_docs: unknown policy %q", s.PublicAPIDocs)
+	}
+	switch normalize(s.Todos) {
+	case "", TodosFlag, TodosTracked, TodosTicket:
+	default:
+		return fmt.Errorf("todos: unknown policy %q", s.Todos)
+	}
+	switch normalize(s.AgentMemos) {
+	case "", AgentMemosFlag, AgentMemosOnly:
+	default:
+		return fmt.Errorf("agent_memos: unknown policy %q", s.AgentMemos)
+	}
+	for _, lang := range append(append([]string(nil), s.AgentMemoLanguages.Add...), s.AgentMemoLanguages.Remove...) {
+		if !isAgentMemoLanguage(normalize(lang)) {
+			return fmt.Errorf("agent_memo_languages: unknown language %q", lang)
+		}
+	}
+	for category, severity := range s.Severity {
+		if !isCategory(models.Category(normalize(category))) {
+			return fmt.Errorf("severity: unknown category %q", category)
+		}
+		if !isSeverity(models.Severity(normalize(severity))) {
+			return fmt.Errorf("severity: unknown severity %q for %s", severity, category)
+		}
+	}
+	for _, label := range s.FlagChanges {
+		if !isLabel(compare.Label(normalize(label))) {
+			return fmt.Errorf("flag_changes: unknown change %q", label)
+		}
+	}
+	return nil
+}
+
+// matches returns true if any of the override's globs matches relPath.
+func (o Override) matches(relPath string) bool {
+	for _, pattern := range o.Files {
+		if matchGlob(pattern, relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// relativePath returns filePath relative to the configuration directory, slash-separated.
+func (c *Config) relativePath(filePath string) string {
+	if c.dir == "" {
+		return filepath.ToSlash(filePath)
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return filepath.ToSlash(filePath)
+	}
+	rel, err := filepath.Rel(c.dir, absPath)
+	if err != nil {
+		return filepath.ToSlash(filePath)
+	}
+	return filepath.ToSlash(rel)
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+func isLabel(label compare.Label) bool {
+	for _, known := range compare.Labels {
+		if label == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isAgentMemoLanguage(lang string) bool {
+	for _, known := range filters.AgentMemoLanguages {
+		if lang == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isCategory(category models.Category) bool {
+	for _, known := range models.Categories {
+		if category == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isSeverity(severity models.Severity) bool {
+	for _, known := range models.Severities {
+		if severity == known {
+			return true
+		}
+	}
+	return false
+}
+
+func normalize(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func removeString(values []string, target string) []string {
+	kept := values[:0]
+	for _, v := range values {
+		if v != target {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
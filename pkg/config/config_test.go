@@ -0,0 +1,392 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/compare"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_RulesFor_DefaultConfig_UsesBuiltInRules(t *testing.T) {
+	// given
+	cfg := Default()
+
+	// when
+	rules := cfg.RulesFor("src/app.py")
+
+	// then
+	assert.True(t, rules.Docstrings)
+	assert.Contains(t, rules.BDDKeywords, "given")
+	assert.Contains(t, rules.DirectivePrefixes, "noqa")
+	assert.Empty(t, rules.AllowPatterns)
+	assert.Empty(t, rules.DenyPatterns)
+}
+
+func Test_Load_KeywordAndDirectivePatches_AppliesAddAndRemove(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+bdd_keywords:
+  add: [Scenario]
+  remove: [act]
+directives:
+  add: [nolint]
+  remove: [allow]
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	rules := cfg.RulesFor("app.go")
+
+	// then
+	assert.Contains(t, rules.BDDKeywords, "scenario")
+	assert.NotContains(t, rules.BDDKeywords, "act")
+	assert.Contains(t, rules.DirectivePrefixes, "nolint")
+	assert.NotContains(t, rules.DirectivePrefixes, "allow")
+}
+
+func Test_Load_OverrideMatchingGlob_AppliesOnlyToMatchingFiles(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	path := writeConfig(t, dir, `
+docstrings: false
+allow_patterns: ["^// SAFETY:"]
+overrides:
+  - files: ["**/*_test.go"]
+    docstrings: true
+    deny_patterns: ["(?i)todo"]
+  - files: ["scripts/*.py"]
+    ignore_languages: [python]
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	mainRules := cfg.RulesFor(filepath.Join(dir, "pkg", "main.go"))
+	testRules := cfg.RulesFor(filepath.Join(dir, "pkg", "main_test.go"))
+	scriptRules := cfg.RulesFor(filepath.Join(dir, "scripts", "run.py"))
+
+	// then
+	assert.False(t, mainRules.Docstrings)
+	assert.Empty(t, mainRules.DenyPatterns)
+	assert.Len(t, mainRules.AllowPatterns, 1)
+	assert.True(t, testRules.Docstrings)
+	assert.Len(t, testRules.DenyPatterns, 1)
+	assert.True(t, scriptRules.IgnoresLanguage("python"))
+	assert.False(t, mainRules.IgnoresLanguage("python"))
+}
+
+func Test_Load_FilterToggles_OverrideReEnablesFilter(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	path := writeConfig(t, dir, `
+filters:
+  disable: [bdd, shebang]
+overrides:
+  - files: ["*_test.py"]
+    filters:
+      enable: [bdd]
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	appRules := cfg.RulesFor(filepath.Join(dir, "app.py"))
+	testRules := cfg.RulesFor(filepath.Join(dir, "app_test.py"))
+
+	// then
+	assert.Contains(t, appRules.DisabledFilters, "bdd")
+	assert.Contains(t, appRules.DisabledFilters, "shebang")
+	assert.NotContains(t, testRules.DisabledFilters, "bdd")
+	assert.Contains(t, testRules.DisabledFilters, "shebang")
+}
+
+func Test_Load_InvalidRegex_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `allow_patterns: ["("]`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, "allow_patterns")
+}
+
+func Test_Load_OverrideWithoutFiles_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+overrides:
+  - docstrings: false
+`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, "override 1 has no files")
+}
+
+func Test_Load_FlagChanges_ReplacesDefaultLabels(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `flag_changes: [added, moved]`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	rules := cfg.RulesFor("main.go")
+	defaults := Default().RulesFor("main.go")
+
+	// then
+	assert.Equal(t, map[compare.Label]struct{}{compare.LabelAdded: {}, compare.LabelMoved: {}}, rules.FlagChanges)
+	assert.Equal(t, map[compare.Label]struct{}{compare.LabelAdded: {}, compare.LabelModified: {}}, defaults.FlagChanges)
+}
+
+func Test_Load_UnknownFlagChange_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `flag_changes: [renamed]`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, `flag_changes: unknown change "renamed"`)
+}
+
+func Test_Load_PublicAPIDocs_SetsPolicy(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+public_api_docs: allow
+overrides:
+  - files: ["internal/**"]
+    public_api_docs: flag
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, PublicAPIDocsFlag, Default().RulesFor("main.go").PublicAPIDocs)
+	assert.Equal(t, PublicAPIDocsAllow, cfg.RulesFor(filepath.Join(filepath.Dir(path), "api.go")).PublicAPIDocs)
+	assert.Equal(t, PublicAPIDocsFlag, cfg.RulesFor(filepath.Join(filepath.Dir(path), "internal", "x.go")).PublicAPIDocs)
+}
+
+func Test_Load_UnknownPublicAPIDocsPolicy_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `public_api_docs: sometimes`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, "public_api_docs")
+}
+
+func Test_Load_Todos_SetsPolicy(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+todos: Tracked
+overrides:
+  - files: ["*.py"]
+    todos: ticket
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, TodosFlag, Default().RulesFor("main.go").Todos)
+	assert.Equal(t, TodosTracked, cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.go")).Todos)
+	assert.Equal(t, TodosTicket, cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.py")).Todos)
+}
+
+func Test_Load_UnknownTodosPolicy_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `todos: never`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, `todos: unknown policy "never"`)
+}
+
+func Test_Load_AgentMemos_SetsPolicy(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+agent_memos: Only
+overrides:
+  - files: ["*.py"]
+    agent_memos: flag
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, AgentMemosFlag, Default().RulesFor("main.go").AgentMemos)
+	assert.Equal(t, AgentMemosOnly, cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.go")).AgentMemos)
+	assert.Equal(t, AgentMemosFlag, cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.py")).AgentMemos)
+}
+
+func Test_Load_UnknownAgentMemosPolicy_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `agent_memos: always`)
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, `agent_memos: unknown policy "always"`)
+}
+
+func Test_Load_AgentMemoLanguages_PatchesDefaultPacks(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+agent_memo_languages:
+  add: [JA, zh]
+  remove: [ko]
+agent_memo_script_detection: true
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	rules := cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.go"))
+
+	// then
+	assert.Equal(t, map[string]struct{}{"en": {}, "ko": {}}, Default().RulesFor("main.go").AgentMemoLanguages)
+	assert.False(t, Default().RulesFor("main.go").AgentMemoScriptDetection)
+	assert.Equal(t, map[string]struct{}{"en": {}, "ja": {}, "zh": {}}, rules.AgentMemoLanguages)
+	assert.True(t, rules.AgentMemoScriptDetection)
+}
+
+func Test_Load_UnknownAgentMemoLanguage_ReturnsError(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), "agent_memo_languages:\n  add: [fr]\n")
+
+	// when
+	_, err := Load(path)
+
+	// then
+	assert.ErrorContains(t, err, `agent_memo_languages: unknown language "fr"`)
+}
+
+func Test_Load_Severity_MapsCategories(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), `
+severity:
+  Docstring: warning
+overrides:
+  - files: ["*.py"]
+    severity:
+      todo: info
+`)
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	goRules := cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.go"))
+	pyRules := cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.py"))
+
+	// then
+	assert.Equal(t, models.SeverityError, Default().RulesFor("main.go").SeverityFor(models.CategoryDocstring))
+	assert.Equal(t, models.SeverityWarning, goRules.SeverityFor(models.CategoryDocstring))
+	assert.Equal(t, models.SeverityError, goRules.SeverityFor(models.CategoryTodo))
+	assert.Equal(t, models.SeverityWarning, pyRules.SeverityFor(models.CategoryDocstring))
+	assert.Equal(t, models.SeverityInfo, pyRules.SeverityFor(models.CategoryTodo))
+	assert.Equal(t, models.SeverityError, pyRules.SeverityFor(models.CategoryAgentMemo))
+}
+
+func Test_RulesFor_License_DefaultsToInfo(t *testing.T) {
+	// given
+	path := writeConfig(t, t.TempDir(), "severity:\n  license: error\n")
+
+	// when
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, models.SeverityInfo, Default().RulesFor("main.go").SeverityFor(models.CategoryLicense))
+	assert.Equal(t, models.SeverityError, cfg.RulesFor(filepath.Join(filepath.Dir(path), "main.go")).SeverityFor(models.CategoryLicense))
+}
+
+func Test_Load_InvalidSeverity_ReturnsError(t *testing.T) {
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{"severity:\n  docs: warning", `severity: unknown category "docs"`},
+		{"severity:\n  docstring: fatal", `severity: unknown severity "fatal" for docstring`},
+	}
+
+	for _, tt := range tests {
+		// given
+		path := writeConfig(t, t.TempDir(), tt.config)
+
+		// when
+		_, err := Load(path)
+
+		// then
+		assert.ErrorContains(t, err, tt.want)
+	}
+}
+
+func Test_Find_ConfigInParentDirectory_ReturnsPath(t *testing.T) {
+	// given
+	root := t.TempDir()
+	expected := writeConfig(t, root, "docstrings: false\n")
+	nested := filepath.Join(root, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+
+	// when
+	found := Find(nested)
+
+	// then
+	assert.Equal(t, expected, found)
+}
+
+func Test_Discover_NoConfig_ReturnsDefault(t *testing.T) {
+	// given
+	dir := t.TempDir()
+
+	// when
+	cfg, err := Discover(dir)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, cfg.RulesFor(filepath.Join(dir, "x.py")).Docstrings)
+}
+
+func Test_Resolver_RulesFor_UsesNearestConfig(t *testing.T) {
+	// given
+	root := t.TempDir()
+	writeConfig(t, root, "docstrings: false\n")
+	nested := filepath.Join(root, "sub")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+	writeConfig(t, nested, "docstrings: true\n")
+	resolver := NewResolver()
+
+	// when
+	rootRules, rootErr := resolver.RulesFor(filepath.Join(root, "a.py"))
+	nestedRules, nestedErr := resolver.RulesFor(filepath.Join(nested, "b.py"))
+
+	// then
+	require.NoError(t, rootErr)
+	require.NoError(t, nestedErr)
+	assert.False(t, rootRules.Docstrings)
+	assert.True(t, nestedRules.Docstrings)
+}
+
+func writeConfig(t *testing.T, dir, content string) string {
+	path := filepath.Join(dir, FileName)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchGlob_BaseNamePattern_MatchesInAnyDirectory(t *testing.T) {
+	// given
+	pattern := "*_test.go"
+
+	// when & then
+	assert.True(t, matchGlob(pattern, "pkg/core/detector_test.go"))
+	assert.False(t, matchGlob(pattern, "pkg/core/detector.go"))
+}
+
+func Test_matchGlob_DoubleStar_MatchesNestedDirectories(t *testing.T) {
+	// given
+	pattern := "src/**/*.ts"
+
+	// when & then
+	assert.True(t, matchGlob(pattern, "src/index.ts"))
+	assert.True(t, matchGlob(pattern, "src/a/b/c.ts"))
+	assert.False(t, matchGlob(pattern, "lib/a.ts"))
+}
+
+func Test_matchGlob_SingleStar_DoesNotCrossDirectories(t *testing.T) {
+	// given
+	pattern := "scripts/*.py"
+
+	// when & then
+	assert.True(t, matchGlob(pattern, "scripts/run.py"))
+	assert.False(t, matchGlob(pattern, "scripts/sub/run.py"))
+}
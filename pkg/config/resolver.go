@@ -0,0 +1,35 @@
+package config
+
+import (
+	"path/filepath"
+)
+
+// Resolver finds and caches the configuration that applies to each file.
+type Resolver struct {
+	byDir map[string]*Config
+}
+
+// NewResolver creates a new Resolver.
+func NewResolver() *Resolver {
+	return &Resolver{byDir: make(map[string]*Config)}
+}
+
+// RulesFor discovers the configuration nearest to filePath and returns its effective rules.
+func (r *Resolver) RulesFor(filePath string) (Rules, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return Rules{}, err
+	}
+
+	dir := filepath.Dir(absPath)
+	cfg, ok := r.byDir[dir]
+	if !ok {
+		cfg, err = Discover(dir)
+		if err != nil {
+			return Rules{}, err
+		}
+		r.byDir[dir] = cfg
+	}
+
+	return cfg.RulesFor(absPath), nil
+}
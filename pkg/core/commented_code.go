@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// blockDelimiters are the opening and closing markers of block comments.
+var blockDelimiters = [][2]string{{"/*", "*/"}, {"<!--", "-->"}, {"{-", "-}"}}
+
+// lineMarkers are the comment markers stripped from the start of each line.
+// A marker character repeated (e.g. "##" or "////") is stripped as a whole.
+var lineMarkers = []string{"//", "#", "--", ";", "*"}
+
+// codePunctuation must appear in a comment body for it to count as code, so
+// single words and prose that happen to parse (e.g. "return value") are not flagged.
+var codePunctuation = regexp.MustCompile(`[(){}\[\];=]`)
+
+// labelPrefix matches prose such as "Note: ..." that parses as a label or an
+// annotated assignment in some grammars.
+var labelPrefix = regexp.MustCompile(`^[A-Za-z_]\w*:\s`)
+
+// isCommentedOutCode returns true if the comment body, with its markers
+// stripped, parses as code in the comment's own language. Single-line bodies
+// must parse cleanly; longer ones may contain one error node per four lines.
+func isCommentedOutCode(text string, lang *sitter.Language) bool {
+	body := stripCommentMarkers(text)
+	if body == "" || !codePunctuation.MatchString(body) {
+		return false
+	}
+	if labelPrefix.MatchString(body) && !strings.Contains(body, "=") {
+		return false
+	}
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(lang)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, []byte(body+"\n"))
+	if err != nil {
+		return false
+	}
+	defer tree.Close()
+
+	lines := strings.Count(body, "\n") + 1
+	return countErrors(tree.RootNode()) <= lines/4
+}
+
+// stripCommentMarkers removes block delimiters and per-line comment markers
+// from a comment and dedents the remaining lines.
+func stripCommentMarkers(text string) string {
+	text = strings.TrimSpace(text)
+	for _, delimiters := range blockDelimiters {
+		if strings.HasPrefix(text, delimiters[0]) && strings.HasSuffix(text, delimiters[1]) && len(text) >= len(delimiters[0])+len(delimiters[1]) {
+			text = text[len(delimiters[0]) : len(text)-len(delimiters[1])]
+			break
+		}
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimLeft(line, " \t")
+		for _, marker := range lineMarkers {
+			if strings.HasPrefix(trimmed, marker) {
+				line = strings.TrimPrefix(strings.TrimLeft(trimmed, marker[:1]), " ")
+				break
+			}
+		}
+		lines[i] = line
+	}
+
+	return strings.TrimSpace(strings.Join(dedent(lines), "\n"))
+}
+
+// dedent removes the indentation shared by all non-blank lines.
+func dedent(lines []string) []string {
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		width := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || width < indent {
+			indent = width
+		}
+	}
+	if indent <= 0 {
+		return lines
+	}
+
+	for i, line := range lines {
+		if len(line) >= indent {
+			lines[i] = line[indent:]
+		} else {
+			lines[i] = ""
+		}
+	}
+	return lines
+}
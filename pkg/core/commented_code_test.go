@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commentedOutCode(code, filePath string) map[string]bool {
+	result := make(map[string]bool)
+	for _, c := range NewCommentDetector().Detect(code, filePath, false) {
+		result[c.Text] = c.IsCommentedOutCode()
+	}
+	return result
+}
+
+func Test_Detect_CommentedOutGoCode_IsMarked(t *testing.T) {
+	// given
+	code := "package main\n\n// fmt.Println(\"debug\")\n// adds one to one\n/*\nif err != nil {\n\treturn err\n}\n*/\n// Note: this is slow (O(n))\n"
+
+	// when
+	result := commentedOutCode(code, "main.go")
+
+	// then
+	assert.True(t, result["// fmt.Println(\"debug\")"])
+	assert.True(t, result["/*\nif err != nil {\n\treturn err\n}\n*/"])
+	assert.False(t, result["// adds one to one"])
+	assert.False(t, result["// Note: this is slow (O(n))"])
+}
+
+func Test_Detect_CommentedOutPythonCode_IsMarked(t *testing.T) {
+	// given
+	code := "# print(x)\n# type: ignore\n# Compute the sum (fast path)\n# return value\nx = 1\n"
+
+	// when
+	result := commentedOutCode(code, "main.py")
+
+	// then
+	assert.True(t, result["# print(x)"])
+	assert.False(t, result["# type: ignore"])
+	assert.False(t, result["# Compute the sum (fast path)"])
+	assert.False(t, result["# return value"])
+}
+
+func Test_Detect_CommentedOutCode_UsesTheCommentsLanguage(t *testing.T) {
+	// given
+	code := "// console.log(debugInfo);\n// https://example.com/a?b=1\nlet x = 1;\n"
+
+	// when
+	result := commentedOutCode(code, "main.js")
+
+	// then
+	assert.True(t, result["// console.log(debugInfo);"])
+	assert.False(t, result["// https://example.com/a?b=1"])
+}
+
+func Test_StripCommentMarkers_KeepsRelativeIndentation(t *testing.T) {
+	// given
+	text := "#  if ready:\n#      start()"
+
+	// when
+	body := stripCommentMarkers(text)
+
+	// then
+	assert.Equal(t, "if ready:\n    start()", body)
+}
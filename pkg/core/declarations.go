@@ -0,0 +1,314 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// declarationTypes lists, per language, the node types a doc comment can document.
+var declarationTypes = map[string]map[string]struct{}{
+	"golang": set("function_declaration", "method_declaration", "type_declaration",
+		"const_declaration", "var_declaration"),
+	"rust": set("function_item", "function_signature_item", "struct_item", "enum_item",
+		"union_item", "trait_item", "type_item", "const_item", "static_item", "mod_item",
+		"macro_definition", "field_declaration"),
+	"typescript": tsDeclarationTypes,
+	"tsx":        tsDeclarationTypes,
+	"javascript": tsDeclarationTypes,
+	"java": set("class_declaration", "interface_declaration", "enum_declaration",
+		"record_declaration", "annotation_type_declaration", "method_declaration",
+		"constructor_declaration", "field_declaration"),
+	"python": set("function_definition", "class_definition", "module"),
+	"ruby":   set("method", "singleton_method", "class", "module"),
+}
+
+var tsDeclarationTypes = set("export_statement", "function_declaration",
+	"generator_function_declaration", "class_declaration", "abstract_class_declaration",
+	"interface_declaration", "type_alias_declaration", "enum_declaration",
+	"lexical_declaration", "variable_declaration", "method_definition",
+	"public_field_definition", "method_signature", "property_signature")
+
+// commentNodeTypes lists the node types grammars use for comments.
+var commentNodeTypes = set("comment", "line_comment", "block_comment", "multiline_comment")
+
+// skippedBetween lists node types allowed between a doc comment and its declaration.
+var skippedBetween = set("comment", "line_comment", "block_comment", "multiline_comment",
+	"attribute_item", "decorator")
+
+// declaration describes the declaration a doc comment is attached to.
+type declaration struct {
+	symbol string
+	public bool
+}
+
+// attachedDeclaration returns the declaration documented by a comment node.
+// Comments must sit on their own line directly above the declaration (other
+// comments, attributes and decorators may come in between); Python docstrings
+// document their enclosing function, class or module.
+func attachedDeclaration(node *sitter.Node, sourceCode []byte, langName string) (declaration, bool) {
+	types, ok := declarationTypes[langName]
+	if !ok {
+		return declaration{}, false
+	}
+
+	target := documentedNode(node, sourceCode)
+	if target == nil {
+		return declaration{}, false
+	}
+	if _, ok := types[target.Type()]; !ok {
+		return declaration{}, false
+	}
+	// Go declarations inside function bodies are locals, not documentable API
+	if langName == "golang" && (target.Parent() == nil || target.Parent().Type() != "source_file") {
+		return declaration{}, false
+	}
+
+	return describeDeclaration(target, sourceCode, langName), true
+}
+
+// enclosingScope returns the names of the declarations containing node,
+// outermost first and joined with dots, e.g. "Greeter.hi".
+func enclosingScope(node *sitter.Node, sourceCode []byte, langName string) string {
+	types, ok := declarationTypes[langName]
+	if !ok {
+		return ""
+	}
+
+	var names []string
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		// Exported declarations are named by the declaration they wrap
+		if _, ok := types[parent.Type()]; !ok || parent.Type() == "export_statement" {
+			continue
+		}
+		if name := describeDeclaration(parent, sourceCode, langName).symbol; name != "" {
+			names = append([]string{name}, names...)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// documentedNode finds the node a comment or docstring node documents.
+// Rust inner doc comments (//! and /*!) document their enclosing item, not
+// the one that follows, so they are not attached.
+func documentedNode(node *sitter.Node, sourceCode []byte) *sitter.Node {
+	if node.Type() == "string" {
+		// Python docstring: string → expression_statement → block/module
+		statement := node.Parent()
+		if statement == nil || statement.Parent() == nil || !isFirstStatement(statement) {
+			return nil
+		}
+		container := statement.Parent()
+		if container.Type() == "module" {
+			return container
+		}
+		return container.Parent()
+	}
+
+	text := node.Content(sourceCode)
+	if strings.HasPrefix(text, "//!") || strings.HasPrefix(text, "/*!") {
+		return nil
+	}
+	if prev := node.PrevNamedSibling(); prev != nil && endsOnLineOf(prev, node) {
+		// Trailing comment after code on the same line
+		return nil
+	}
+
+	end := lastRow(node)
+	for next := node.NextNamedSibling(); next != nil; next = next.NextNamedSibling() {
+		if next.StartPoint().Row > end+1 {
+			return nil
+		}
+		if _, skip := skippedBetween[next.Type()]; !skip {
+			return next
+		}
+		end = lastRow(next)
+	}
+	return nil
+}
+
+// endsOnLineOf returns true if code in prev ends on the line node starts on.
+// Comments never count, and neither does a node whose end includes the line
+// break, such as a tree-sitter-rust line_comment ending at column 0.
+func endsOnLineOf(prev, node *sitter.Node) bool {
+	if _, isComment := commentNodeTypes[prev.Type()]; isComment {
+		return false
+	}
+	return prev.EndPoint().Row == node.StartPoint().Row && prev.EndPoint().Column > 0
+}
+
+// lastRow returns the row of a node's last character, not counting a
+// trailing line break included in the node.
+func lastRow(node *sitter.Node) uint32 {
+	end := node.EndPoint()
+	if end.Column == 0 && end.Row > node.StartPoint().Row {
+		return end.Row - 1
+	}
+	return end.Row
+}
+
+// isFirstStatement returns true if only comments precede the statement in its block.
+func isFirstStatement(statement *sitter.Node) bool {
+	for prev := statement.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		if prev.Type() != "comment" {
+			return false
+		}
+	}
+	return true
+}
+
+// describeDeclaration extracts a declaration's name and whether it is public API.
+func describeDeclaration(node *sitter.Node, sourceCode []byte, langName string) declaration {
+	switch langName {
+	case "golang":
+		name := goDeclarationName(node, sourceCode)
+		return declaration{symbol: name, public: isExportedGoName(name)}
+	case "rust":
+		return declaration{symbol: fieldContent(node, "name", sourceCode), public: hasRustPubVisibility(node, sourceCode)}
+	case "typescript", "tsx", "javascript":
+		return describeTSDeclaration(node, sourceCode)
+	case "java":
+		return declaration{symbol: javaDeclarationName(node, sourceCode), public: hasModifier(node, "public", sourceCode)}
+	case "python":
+		if node.Type() == "module" {
+			return declaration{public: true}
+		}
+		name := fieldContent(node, "name", sourceCode)
+		return declaration{symbol: name, public: isPublicPythonName(name)}
+	case "ruby":
+		// Ruby methods are public unless made private elsewhere in the class
+		return declaration{symbol: fieldContent(node, "name", sourceCode), public: true}
+	}
+	return declaration{}
+}
+
+// goDeclarationName returns the declared name, using the first spec of grouped declarations.
+func goDeclarationName(node *sitter.Node, sourceCode []byte) string {
+	if name := fieldContent(node, "name", sourceCode); name != "" {
+		return name
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		spec := node.NamedChild(i)
+		if strings.HasSuffix(spec.Type(), "_spec") {
+			return fieldContent(spec, "name", sourceCode)
+		}
+	}
+	return ""
+}
+
+func isExportedGoName(name string) bool {
+	for _, r := range name {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
+
+// hasRustPubVisibility returns true for items declared plain `pub`;
+// restricted visibility such as `pub(crate)` is not public API.
+func hasRustPubVisibility(node *sitter.Node, sourceCode []byte) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "visibility_modifier" {
+			return child.Content(sourceCode) == "pub"
+		}
+	}
+	return false
+}
+
+// describeTSDeclaration handles exported declarations and class members of exported classes.
+func describeTSDeclaration(node *sitter.Node, sourceCode []byte) declaration {
+	switch node.Type() {
+	case "export_statement":
+		if inner := node.ChildByFieldName("declaration"); inner != nil {
+			return declaration{symbol: tsDeclarationName(inner, sourceCode), public: true}
+		}
+		return declaration{public: true}
+	case "method_definition", "public_field_definition", "method_signature", "property_signature":
+		name := fieldContent(node, "name", sourceCode)
+		private := strings.HasPrefix(name, "#") ||
+			hasModifier(node, "private", sourceCode) || hasModifier(node, "protected", sourceCode)
+		return declaration{symbol: name, public: !private && isExportedMember(node)}
+	}
+	return declaration{symbol: tsDeclarationName(node, sourceCode)}
+}
+
+// tsDeclarationName returns the name of a declaration, looking into variable declarators.
+func tsDeclarationName(node *sitter.Node, sourceCode []byte) string {
+	if name := fieldContent(node, "name", sourceCode); name != "" {
+		return name
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "variable_declarator" {
+			return fieldContent(child, "name", sourceCode)
+		}
+	}
+	return ""
+}
+
+// isExportedMember returns true if a class or interface member belongs to an exported declaration.
+func isExportedMember(node *sitter.Node) bool {
+	body := node.Parent()
+	if body == nil || body.Parent() == nil {
+		return false
+	}
+	owner := body.Parent().Parent()
+	return owner != nil && owner.Type() == "export_statement"
+}
+
+// javaDeclarationName returns the declared name, looking into field declarators.
+func javaDeclarationName(node *sitter.Node, sourceCode []byte) string {
+	if name := fieldContent(node, "name", sourceCode); name != "" {
+		return name
+	}
+	if declarator := node.ChildByFieldName("declarator"); declarator != nil {
+		return fieldContent(declarator, "name", sourceCode)
+	}
+	return ""
+}
+
+// hasModifier returns true if the node carries the modifier keyword, either in
+// a Java "modifiers" node or a TypeScript accessibility modifier.
+func hasModifier(node *sitter.Node, keyword string, sourceCode []byte) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "modifiers":
+			for _, word := range strings.Fields(child.Content(sourceCode)) {
+				if word == keyword {
+					return true
+				}
+			}
+		case "accessibility_modifier":
+			if child.Content(sourceCode) == keyword {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isPublicPythonName returns true for names without a leading underscore and for dunder names.
+func isPublicPythonName(name string) bool {
+	if strings.HasPrefix(name, "__") && strings.HasSuffix(name, "__") {
+		return true
+	}
+	return name != "" && !strings.HasPrefix(name, "_")
+}
+
+// fieldContent returns the source text of a node's named field, or empty string.
+func fieldContent(node *sitter.Node, field string, sourceCode []byte) string {
+	child := node.ChildByFieldName(field)
+	if child == nil {
+		return ""
+	}
+	return child.Content(sourceCode)
+}
+
+func set(values ...string) map[string]struct{} {
+	result := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		result[v] = struct{}{}
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// apiMetadata returns the symbol and public_api metadata of each detected comment by text.
+func apiMetadata(t *testing.T, code, filePath string) map[string][2]string {
+	t.Helper()
+	result := make(map[string][2]string)
+	for _, c := range NewCommentDetector().Detect(code, filePath, true) {
+		result[c.Text] = [2]string{c.Metadata[models.MetadataSymbol], c.Metadata[models.MetadataPublicAPI]}
+	}
+	return result
+}
+
+func Test_Detect_GoDocComments_RecordExportedSymbols(t *testing.T) {
+	// given
+	code := `package main
+
+// Parse reads input.
+// It returns an error on failure.
+func Parse() error { return nil }
+
+// helper is private.
+func helper() {
+	// inline note
+	x := 1 // trailing
+	_ = x
+}
+
+// Config holds settings.
+type Config struct{}
+
+// Limit caps retries.
+const Limit = 3
+`
+
+	// when
+	result := apiMetadata(t, code, "main.go")
+
+	// then
+	assert.Equal(t, [2]string{"Parse", "true"}, result["// Parse reads input."])
+	assert.Equal(t, [2]string{"Parse", "true"}, result["// It returns an error on failure."])
+	assert.Equal(t, [2]string{"helper", "false"}, result["// helper is private."])
+	assert.Equal(t, [2]string{"", ""}, result["// inline note"])
+	assert.Equal(t, [2]string{"", ""}, result["// trailing"])
+	assert.Equal(t, [2]string{"Config", "true"}, result["// Config holds settings."])
+	assert.Equal(t, [2]string{"Limit", "true"}, result["// Limit caps retries."])
+}
+
+func Test_Detect_GoLocalDeclarations_AreNotDocumented(t *testing.T) {
+	// given
+	code := "package main\n\nfunc run() {\n\t// increment the counter\n\tvar Total = 1\n\t// the limit\n\tconst Max = 2\n\t_, _ = Total, Max\n}\n"
+
+	// when
+	comments := NewCommentDetector().Detect(code, "main.go", true)
+
+	// then
+	require.Len(t, comments, 2)
+	for _, c := range comments {
+		assert.Equal(t, models.CommentTypeLine, c.CommentType, c.Text)
+		assert.False(t, c.IsDocstring, c.Text)
+		assert.Empty(t, c.Metadata[models.MetadataPublicAPI], c.Text)
+		assert.Empty(t, c.Metadata[models.MetadataSymbol], c.Text)
+	}
+}
+
+func Test_Detect_RustDocComments_OnlyPlainPubIsPublic(t *testing.T) {
+	// given
+	code := "/// Point doc\n#[derive(Debug)]\npub struct Point { x: i32 }\n\n/// crate only\npub(crate) fn f() {}\n\n/// private\nfn g() {}\n"
+
+	// when
+	result := apiMetadata(t, code, "lib.rs")
+
+	// then
+	assert.Equal(t, [2]string{"Point", "true"}, result["/// Point doc"])
+	assert.Equal(t, [2]string{"f", "false"}, result["/// crate only"])
+	assert.Equal(t, [2]string{"g", "false"}, result["/// private"])
+}
+
+func Test_Detect_RustMultiLineDocComments_AllLinesAttached(t *testing.T) {
+	// given
+	code := "//! Crate docs.\npub fn first() {}\n\n/// Adds numbers.\n/// Returns the sum.\npub fn add(a: i32, b: i32) -> i32 { a + b }\n"
+
+	// when
+	result := apiMetadata(t, code, "lib.rs")
+
+	// then
+	assert.Equal(t, [2]string{"add", "true"}, result["/// Adds numbers."])
+	assert.Equal(t, [2]string{"add", "true"}, result["/// Returns the sum."])
+	assert.Equal(t, [2]string{"", ""}, result["//! Crate docs."])
+}
+
+func Test_Detect_TypeScriptDocComments_ExportedSymbolsArePublic(t *testing.T) {
+	// given
+	code := `/** Exported. */
+export function run() {}
+
+/** Local. */
+function helper() {}
+
+export class Client {
+  /** Public method. */
+  send() {}
+
+  /** Private method. */
+  private retry() {}
+}
+`
+
+	// when
+	result := apiMetadata(t, code, "client.ts")
+
+	// then
+	assert.Equal(t, [2]string{"run", "true"}, result["/** Exported. */"])
+	assert.Equal(t, [2]string{"helper", "false"}, result["/** Local. */"])
+	assert.Equal(t, [2]string{"send", "true"}, result["/** Public method. */"])
+	assert.Equal(t, [2]string{"retry", "false"}, result["/** Private method. */"])
+}
+
+func Test_Detect_JavaDocComments_PublicMembersArePublic(t *testing.T) {
+	// given
+	code := `/** Service. */
+public class Service {
+    /** Runs. */
+    public void run() {}
+
+    /** Count. */
+    private int count;
+}
+`
+
+	// when
+	result := apiMetadata(t, code, "Service.java")
+
+	// then
+	assert.Equal(t, [2]string{"Service", "true"}, result["/** Service. */"])
+	assert.Equal(t, [2]string{"run", "true"}, result["/** Runs. */"])
+	assert.Equal(t, [2]string{"count", "false"}, result["/** Count. */"])
+}
+
+func Test_Detect_PythonDocstrings_UnderscoreNamesArePrivate(t *testing.T) {
+	// given
+	code := "\"\"\"Module doc.\"\"\"\n\ndef run():\n    \"\"\"Run.\"\"\"\n\ndef _helper():\n    \"\"\"Help.\"\"\"\n"
+
+	// when
+	comments := NewCommentDetector().Detect(code, "app.py", true)
+	result := apiMetadata(t, code, "app.py")
+
+	// then
+	require.Len(t, comments, 3)
+	assert.Equal(t, [2]string{"", "true"}, result["\"\"\"Module doc.\"\"\""])
+	assert.Equal(t, [2]string{"run", "true"}, result["\"\"\"Run.\"\"\""])
+	assert.Equal(t, [2]string{"_helper", "false"}, result["\"\"\"Help.\"\"\""])
+}
+
+func Test_Detect_RecordsEnclosingScope(t *testing.T) {
+	// given
+	code := "// top\nexport class Greeter {\n  // field\n  hi() {\n    // body\n  }\n}\nexport function run() {\n  // in run\n}\n"
+
+	// when
+	scopes := make(map[string]string)
+	for _, c := range NewCommentDetector().Detect(code, "greeter.ts", true) {
+		scopes[c.Text] = c.Metadata[models.MetadataScope]
+	}
+
+	// then
+	assert.Equal(t, "", scopes["// top"])
+	assert.Equal(t, "Greeter", scopes["// field"])
+	assert.Equal(t, "Greeter.hi", scopes["// body"])
+	assert.Equal(t, "run", scopes["// in run"])
+}
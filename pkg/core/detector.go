@@ -1,9 +1,13 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	sitter "github.com/smacker/go-tree-sitter"
 
@@ -22,8 +26,9 @@ func NewCommentDetector() *CommentDetector {
 	}
 }
 
-// Detect extracts comments from the given source code.
-func (d *CommentDetector) Detect(content, filePath string, includeDocstrings bool) []models.CommentInfo {
+// languageFor resolves the tree-sitter language for a file path.
+// Returns an empty name and nil language if the file type is unsupported.
+func (d *CommentDetector) languageFor(filePath string) (string, *sitter.Language) {
 	ext := strings.TrimPrefix(filepath.Ext(filePath), ".")
 	if ext == "" {
 		// Handle files like "Dockerfile"
@@ -32,10 +37,55 @@ func (d *CommentDetector) Detect(content, filePath string, includeDocstrings boo
 
 	langName := d.registry.GetLanguageName(ext)
 	if langName == "" {
-		return nil
+		return "", nil
 	}
 
 	lang := GetLanguage(langName)
+	if lang == nil {
+		return "", nil
+	}
+	return langName, lang
+}
+
+// ErrorCount parses the source code and returns the number of syntax error nodes
+// in the resulting tree. Returns -1 if the file type is unsupported or parsing fails.
+func (d *CommentDetector) ErrorCount(content, filePath string) int {
+	_, lang := d.languageFor(filePath)
+	if lang == nil {
+		return -1
+	}
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(lang)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, []byte(content))
+	if err != nil {
+		return -1
+	}
+	defer tree.Close()
+
+	return countErrors(tree.RootNode())
+}
+
+// countErrors counts ERROR and MISSING nodes under the given node.
+func countErrors(node *sitter.Node) int {
+	if !node.HasError() {
+		return 0
+	}
+
+	count := 0
+	if node.IsError() || node.IsMissing() {
+		count++
+	}
+	for i := 0; i < int(node.ChildCount()); i++ {
+		count += countErrors(node.Child(i))
+	}
+	return count
+}
+
+// Detect extracts comments from the given source code.
+func (d *CommentDetector) Detect(content, filePath string, includeDocstrings bool) []models.CommentInfo {
+	langName, lang := d.languageFor(filePath)
 	if lang == nil {
 		return nil
 	}
@@ -74,51 +124,74 @@ func (d *CommentDetector) Detect(content, filePath string, includeDocstrings boo
 		for _, capture := range match.Captures {
 			node := capture.Node
 			text := node.Content(sourceCode)
-			lineNumber := int(node.StartPoint().Row) + 1
-
 			commentType := d.determineCommentType(text, node.Type())
+			if isDocComment(node, text, sourceCode, langName) {
+				commentType = models.CommentTypeDocstring
+			}
 			isDocstring := commentType == models.CommentTypeDocstring
 
 			if isDocstring && !includeDocstrings {
 				continue
 			}
 
-			comments = append(comments, models.CommentInfo{
-				Text:        text,
-				LineNumber:  lineNumber,
-				FilePath:    filePath,
-				CommentType: commentType,
-				IsDocstring: isDocstring,
-			})
+			info := newCommentInfo(node, sourceCode, filePath, langName, commentType)
+			if !isDocstring && isCommentedOutCode(info.Text, lang) {
+				info.Metadata[models.MetadataCommentedOutCode] = "true"
+			}
+			comments = append(comments, info)
 		}
 	}
 
-	// Detect docstrings if requested
+	nodeCount := len(comments)
+	docstrings := d.detectDocstrings(tree.RootNode(), sourceCode, filePath, lang, langName)
 	if includeDocstrings {
-		docstrings := d.detectDocstrings(sourceCode, filePath, lang, langName)
 		comments = append(comments, docstrings...)
 	}
 
+	// String comments are reported regardless of includeDocstrings
+	comments = append(comments, d.detectStringComments(tree.RootNode(), sourceCode, filePath, lang, langName, docstrings)...)
+
+	applyPragmas(comments, nodeCount)
+
 	return comments
 }
 
-// detectDocstrings extracts docstrings using language-specific queries.
-func (d *CommentDetector) detectDocstrings(sourceCode []byte, filePath string, lang *sitter.Language, langName string) []models.CommentInfo {
-	docQuery, ok := DocstringQueries[langName]
-	if !ok {
-		return nil
+// detectDocstrings finds docstrings that are not comment nodes, such as Python
+// string docstrings and Elixir @doc attributes.
+func (d *CommentDetector) detectDocstrings(root *sitter.Node, sourceCode []byte, filePath string, lang *sitter.Language, langName string) []models.CommentInfo {
+	var docstrings []models.CommentInfo
+	for _, node := range queryCaptures(root, sourceCode, lang, DocstringQueries[langName], "docstring") {
+		docstrings = append(docstrings, newCommentInfo(node, sourceCode, filePath, langName, models.CommentTypeDocstring))
 	}
+	return docstrings
+}
 
-	parser := sitter.NewParser()
-	parser.SetLanguage(lang)
+// detectStringComments finds string literals used as comments, skipping those
+// that are docstrings.
+func (d *CommentDetector) detectStringComments(root *sitter.Node, sourceCode []byte, filePath string, lang *sitter.Language, langName string, docstrings []models.CommentInfo) []models.CommentInfo {
+	isDocstring := make(map[int]struct{}, len(docstrings))
+	for _, docstring := range docstrings {
+		isDocstring[docstring.StartByte] = struct{}{}
+	}
 
-	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
-	if err != nil {
+	var comments []models.CommentInfo
+	for _, node := range queryCaptures(root, sourceCode, lang, StringCommentQueries[langName], "string_comment") {
+		if _, ok := isDocstring[int(node.StartByte())]; ok {
+			continue
+		}
+		comments = append(comments, newCommentInfo(node, sourceCode, filePath, langName, models.CommentTypeStringComment))
+	}
+	return comments
+}
+
+// queryCaptures runs a query and returns the nodes captured under the given name,
+// applying #match? and #eq? predicates. Returns nil for an empty or invalid query.
+func queryCaptures(root *sitter.Node, sourceCode []byte, lang *sitter.Language, pattern, captureName string) []*sitter.Node {
+	if pattern == "" {
 		return nil
 	}
-	defer tree.Close()
 
-	query, err := sitter.NewQuery([]byte(docQuery), lang)
+	query, err := sitter.NewQuery([]byte(pattern), lang)
 	if err != nil {
 		return nil
 	}
@@ -126,30 +199,119 @@ func (d *CommentDetector) detectDocstrings(sourceCode []byte, filePath string, l
 
 	qc := sitter.NewQueryCursor()
 	defer qc.Close()
-	qc.Exec(query, tree.RootNode())
+	qc.Exec(query, root)
 
-	var docstrings []models.CommentInfo
+	var nodes []*sitter.Node
 	for {
 		match, ok := qc.NextMatch()
 		if !ok {
 			break
 		}
+		match = qc.FilterPredicates(match, sourceCode)
 		for _, capture := range match.Captures {
-			node := capture.Node
-			text := node.Content(sourceCode)
-			lineNumber := int(node.StartPoint().Row) + 1
-
-			docstrings = append(docstrings, models.CommentInfo{
-				Text:        text,
-				LineNumber:  lineNumber,
-				FilePath:    filePath,
-				CommentType: models.CommentTypeDocstring,
-				IsDocstring: true,
-			})
+			if query.CaptureNameForId(capture.Index) == captureName {
+				nodes = append(nodes, capture.Node)
+			}
 		}
 	}
+	return nodes
+}
 
-	return docstrings
+// newCommentInfo builds a CommentInfo covering the node's exact source range.
+// Trailing line breaks that some grammars include in comment nodes are excluded.
+// Comments documenting a declaration record its symbol and public API status.
+func newCommentInfo(node *sitter.Node, sourceCode []byte, filePath, langName string, commentType models.CommentType) models.CommentInfo {
+	startByte := int(node.StartByte())
+	endByte := int(node.EndByte())
+	for endByte > startByte && (sourceCode[endByte-1] == '\n' || sourceCode[endByte-1] == '\r') {
+		endByte--
+	}
+	text := string(sourceCode[startByte:endByte])
+
+	start := node.StartPoint()
+	endLine := int(start.Row) + 1 + strings.Count(text, "\n")
+	endColumn := len(text) - strings.LastIndex(text, "\n")
+	if endLine == int(start.Row)+1 {
+		endColumn = int(start.Column) + len(text) + 1
+	}
+	runeColumn, runeEndColumn := runeColumns(sourceCode, startByte, text)
+
+	info := models.CommentInfo{
+		Text:          text,
+		LineNumber:    int(start.Row) + 1,
+		Column:        int(start.Column) + 1,
+		EndLine:       endLine,
+		EndColumn:     endColumn,
+		RuneColumn:    runeColumn,
+		RuneEndColumn: runeEndColumn,
+		StartByte:     startByte,
+		EndByte:       endByte,
+		FilePath:      filePath,
+		CommentType:   commentType,
+		IsDocstring:   commentType == models.CommentTypeDocstring,
+		Metadata:      map[string]string{models.MetadataLanguage: langName},
+	}
+
+	if decl, ok := attachedDeclaration(node, sourceCode, langName); ok {
+		info.Metadata[models.MetadataSymbol] = decl.symbol
+		info.Metadata[models.MetadataPublicAPI] = strconv.FormatBool(decl.public)
+	}
+	if scope := enclosingScope(node, sourceCode, langName); scope != "" {
+		info.Metadata[models.MetadataScope] = scope
+	}
+
+	return info
+}
+
+// runeColumns returns the 1-based code point columns of a comment's start and
+// of the position just past its last character.
+func runeColumns(sourceCode []byte, startByte int, text string) (int, int) {
+	lineStart := bytes.LastIndexByte(sourceCode[:startByte], '\n') + 1
+	column := utf8.RuneCount(sourceCode[lineStart:startByte]) + 1
+	if i := strings.LastIndex(text, "\n"); i >= 0 {
+		return column, utf8.RuneCountInString(text[i+1:]) + 1
+	}
+	return column, column + utf8.RuneCountInString(text)
+}
+
+// isDocComment returns true if a comment node is documentation: it starts with
+// one of the language's doc comment prefixes, or, in languages whose doc
+// comments are plain comments, it is attached to a declaration. Ruby comments
+// carrying a YARD tag (e.g. "# @param") are always documentation.
+func isDocComment(node *sitter.Node, text string, sourceCode []byte, langName string) bool {
+	stripped := strings.TrimSpace(text)
+	for _, prefix := range DocCommentPrefixes[langName] {
+		if hasDocPrefix(stripped, prefix) {
+			return true
+		}
+	}
+
+	if langName == "ruby" && yardTagPattern.MatchString(stripped) {
+		return true
+	}
+	if _, ok := DeclarationDocLanguages[langName]; ok {
+		_, attached := attachedDeclaration(node, sourceCode, langName)
+		return attached
+	}
+	return false
+}
+
+// yardTagPattern matches Ruby comments starting with a YARD tag.
+var yardTagPattern = regexp.MustCompile(`^#\s*@[a-z_]+`)
+
+// hasDocPrefix returns true if text starts with the doc prefix and is not one of
+// the look-alikes that are ordinary comments: "////...", "/**/" and "/***...".
+func hasDocPrefix(text, prefix string) bool {
+	if !strings.HasPrefix(text, prefix) {
+		return false
+	}
+	switch prefix {
+	case "///":
+		return !strings.HasPrefix(text, "////")
+	case "/**":
+		return text != "/**/" && !strings.HasPrefix(text, "/***")
+	}
+	return true
 }
 
 // determineCommentType determines the type of comment based on its text and node type.
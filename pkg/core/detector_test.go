@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
 )
 
@@ -98,3 +99,237 @@ func main() {}`
 	assert.Equal(t, models.CommentTypeLine, comments[0].CommentType)
 	assert.False(t, comments[0].IsDocstring)
 }
+
+func Test_Detect_SetsLanguageMetadata(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "// comment\nfn main() {}"
+
+	// when
+	comments := detector.Detect(code, "main.rs", false)
+
+	// then
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "rust", comments[0].Metadata[models.MetadataLanguage])
+}
+
+func Test_Detect_TrailingComment_RecordsColumn(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "x = 1  # trailing\n"
+
+	// when
+	comments := detector.Detect(code, "test.py", false)
+
+	// then
+	assert.Len(t, comments, 1)
+	assert.Equal(t, 1, comments[0].LineNumber)
+	assert.Equal(t, 8, comments[0].Column)
+}
+
+func Test_Detect_MultiLineBlockComment_RecordsFullRange(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "int x;\n  /* first\n     second\n     third */\nint y;\n"
+
+	// when
+	comments := detector.Detect(code, "main.c", false)
+
+	// then
+	assert.Len(t, comments, 1)
+	c := comments[0]
+	assert.Equal(t, 2, c.LineNumber)
+	assert.Equal(t, 3, c.Column)
+	assert.Equal(t, 4, c.EndLine)
+	assert.Equal(t, 14, c.EndColumn)
+	assert.Equal(t, code[c.StartByte:c.EndByte], c.Text)
+	assert.Equal(t, 9, c.StartByte)
+}
+
+func Test_Detect_NonASCIIPrefix_RecordsRuneColumns(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "package main\n\nvar s = \"한글한글\" // note here\n"
+
+	// when
+	comments := detector.Detect(code, "main.go", false)
+
+	// then
+	require.Len(t, comments, 1)
+	c := comments[0]
+	assert.Equal(t, 24, c.Column)
+	assert.Equal(t, 16, c.RuneColumn)
+	assert.Equal(t, 28, c.RuneEndColumn)
+}
+
+func Test_Detect_RustDocComment_ExcludesTrailingNewline(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "/// doc\nfn main() {}\n"
+
+	// when
+	comments := detector.Detect(code, "main.rs", true)
+
+	// then
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "/// doc", comments[0].Text)
+	assert.Equal(t, 1, comments[0].EndLine)
+	assert.Equal(t, 8, comments[0].EndColumn)
+	assert.Equal(t, 7, comments[0].EndByte)
+}
+
+func Test_ErrorCount_ValidAndBrokenCode(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+
+	// when
+	valid := detector.ErrorCount("int main(void) { return 0; }\n", "main.c")
+	broken := detector.ErrorCount("int main(void) { return 0;\n", "main.c")
+	unsupported := detector.ErrorCount("text", "notes.txt")
+
+	// then
+	assert.Equal(t, 0, valid)
+	assert.Greater(t, broken, 0)
+	assert.Equal(t, -1, unsupported)
+}
+
+// docTypes returns the comment type of each detected comment keyed by text.
+func docTypes(code, filePath string) map[string]models.CommentType {
+	result := make(map[string]models.CommentType)
+	for _, c := range NewCommentDetector().Detect(code, filePath, true) {
+		result[c.Text] = c.CommentType
+	}
+	return result
+}
+
+func Test_Detect_RustDocComments_AreDocstrings(t *testing.T) {
+	// given
+	code := "//! crate doc\n\n/// item doc\nfn f() {\n    // plain\n    //// not doc\n}\n/** block doc */\nfn g() {}\n"
+
+	// when
+	result := docTypes(code, "lib.rs")
+
+	// then
+	assert.Equal(t, models.CommentTypeDocstring, result["//! crate doc"])
+	assert.Equal(t, models.CommentTypeDocstring, result["/// item doc"])
+	assert.Equal(t, models.CommentTypeDocstring, result["/** block doc */"])
+	assert.Equal(t, models.CommentTypeLine, result["// plain"])
+	assert.Equal(t, models.CommentTypeLine, result["//// not doc"])
+}
+
+func Test_Detect_GoDeclarationComments_AreDocstrings(t *testing.T) {
+	// given
+	code := "package main\n\n// Run starts.\nfunc Run() {\n\t// step\n}\n"
+
+	// when
+	result := docTypes(code, "main.go")
+
+	// then
+	assert.Equal(t, models.CommentTypeDocstring, result["// Run starts."])
+	assert.Equal(t, models.CommentTypeLine, result["// step"])
+}
+
+func Test_Detect_CSharpXMLDocs_AreDocstrings(t *testing.T) {
+	// given
+	code := "class A {\n    /// <summary>Runs.</summary>\n    public void Run() {\n        // step\n    }\n}\n"
+
+	// when
+	result := docTypes(code, "A.cs")
+
+	// then
+	assert.Equal(t, models.CommentTypeDocstring, result["/// <summary>Runs.</summary>"])
+	assert.Equal(t, models.CommentTypeLine, result["// step"])
+}
+
+func Test_Detect_KotlinSwiftPHPDocs_AreDocstrings(t *testing.T) {
+	// given
+	kotlin := "/** KDoc. */\nfun run() {}\n/* plain */\n"
+	swift := "/// Swift doc.\nfunc run() {}\n/** Swift block doc. */\nfunc stop() {}\n"
+	php := "<?php\n/** PHPDoc. */\nfunction run() {}\n/* plain */\n"
+
+	// when
+	kotlinTypes := docTypes(kotlin, "a.kt")
+	swiftTypes := docTypes(swift, "a.swift")
+	phpTypes := docTypes(php, "a.php")
+
+	// then
+	assert.Equal(t, models.CommentTypeDocstring, kotlinTypes["/** KDoc. */"])
+	assert.Equal(t, models.CommentTypeBlock, kotlinTypes["/* plain */"])
+	assert.Equal(t, models.CommentTypeDocstring, swiftTypes["/// Swift doc."])
+	assert.Equal(t, models.CommentTypeDocstring, swiftTypes["/** Swift block doc. */"])
+	assert.Equal(t, models.CommentTypeDocstring, phpTypes["/** PHPDoc. */"])
+	assert.Equal(t, models.CommentTypeBlock, phpTypes["/* plain */"])
+}
+
+func Test_Detect_RubyDocs_AreDocstrings(t *testing.T) {
+	// given
+	code := "# Greets a person.\n# @param name [String]\ndef greet(name)\n  # build\n  \"hi #{name}\"\nend\n\nx = 1\n# @todo tidy\n"
+
+	// when
+	result := docTypes(code, "a.rb")
+
+	// then
+	assert.Equal(t, models.CommentTypeDocstring, result["# Greets a person."])
+	assert.Equal(t, models.CommentTypeDocstring, result["# @param name [String]"])
+	assert.Equal(t, models.CommentTypeDocstring, result["# @todo tidy"])
+	assert.Equal(t, models.CommentTypeLine, result["# build"])
+}
+
+func Test_Detect_JSDoc_ReportedOnceAsDocstring(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "// plain\n/** doc */\nfunction f() {}\n"
+
+	// when
+	comments := detector.Detect(code, "a.js", true)
+
+	// then
+	assert.Len(t, comments, 2)
+	assert.Equal(t, models.CommentTypeLine, comments[0].CommentType)
+	assert.Equal(t, models.CommentTypeDocstring, comments[1].CommentType)
+	assert.True(t, comments[1].IsDocstring)
+}
+
+func Test_Detect_DocCommentsExcludedWithoutDocstrings(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "/** doc */\npublic class A {\n    // plain\n}\n"
+
+	// when
+	comments := detector.Detect(code, "A.java", false)
+
+	// then
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "// plain", comments[0].Text)
+}
+
+func Test_Detect_ElixirDocAttributes_AreDocstrings(t *testing.T) {
+	// given
+	code := "defmodule Greeter do\n  @moduledoc \"\"\"\n  Greets people.\n  \"\"\"\n\n  @doc ~S\"Says hi.\"\n  @doc false\n  @greeting \"hi\"\n  def hi, do: @greeting\nend\n"
+
+	// when
+	result := docTypes(code, "greeter.ex")
+
+	// then
+	assert.Len(t, result, 2)
+	assert.Equal(t, models.CommentTypeDocstring, result["@moduledoc \"\"\"\n  Greets people.\n  \"\"\""])
+	assert.Equal(t, models.CommentTypeDocstring, result["@doc ~S\"Says hi.\""])
+}
+
+func Test_Detect_PythonBareStrings_AreStringComments(t *testing.T) {
+	// given
+	detector := NewCommentDetector()
+	code := "#!/usr/bin/env python\n\"\"\"Module doc.\"\"\"\n\ndef f():\n    \"\"\"Doc.\"\"\"\n    x = 1\n    \"\"\"Now add one.\"\"\"\n    return x + 1\n"
+
+	// when
+	comments := detector.Detect(code, "a.py", false)
+
+	// then
+	require.Len(t, comments, 2)
+	assert.Equal(t, models.CommentTypeLine, comments[0].CommentType)
+	assert.Equal(t, `"""Now add one."""`, comments[1].Text)
+	assert.Equal(t, models.CommentTypeStringComment, comments[1].CommentType)
+	assert.Equal(t, 7, comments[1].LineNumber)
+	assert.False(t, comments[1].IsDocstring)
+	assert.NotContains(t, comments[1].Metadata, models.MetadataSymbol)
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// Pragma kinds recognized in comments, e.g. "// comment-checker: allow-next-line".
+const (
+	// PragmaAllow suppresses the comments on the pragma's own line(s).
+	PragmaAllow = "allow"
+	// PragmaAllowNextLine suppresses the comments starting on the line after the pragma.
+	PragmaAllowNextLine = "allow-next-line"
+	// PragmaDisable suppresses every comment until the next enable pragma.
+	PragmaDisable = "disable"
+	// PragmaEnable ends a region started by a disable pragma.
+	PragmaEnable = "enable"
+)
+
+// pragmaPattern matches a pragma and the reason written after it on the same line.
+var pragmaPattern = regexp.MustCompile(`comment-checker:\s*(allow-next-line|allow|disable|enable)\b(.*)`)
+
+// pragma is a comment-checker pragma found in a comment.
+type pragma struct {
+	kind      string
+	reason    string
+	firstLine int
+	lastLine  int
+}
+
+// applyPragmas marks the comments suppressed by comment-checker pragmas with
+// the pragma's kind and reason. Only the first nodeCount comments come from
+// comment nodes; pragmas are collected from those alone, so string docstrings
+// and string comments can be suppressed but never suppress anything.
+func applyPragmas(comments []models.CommentInfo, nodeCount int) {
+	var pragmas []pragma
+	for _, c := range comments[:nodeCount] {
+		if p, ok := parsePragma(c); ok {
+			pragmas = append(pragmas, p)
+		}
+	}
+	if len(pragmas) == 0 {
+		return
+	}
+	sort.SliceStable(pragmas, func(i, j int) bool {
+		return pragmas[i].firstLine < pragmas[j].firstLine
+	})
+
+	for i, c := range comments {
+		p, ok := pragma{}, false
+		if i < nodeCount {
+			p, ok = parsePragma(c)
+		}
+		if !ok {
+			p, ok = suppressingPragma(c, pragmas)
+		}
+		if !ok {
+			continue
+		}
+		comments[i].Metadata[models.MetadataPragma] = p.kind
+		if p.reason != "" {
+			comments[i].Metadata[models.MetadataPragmaReason] = p.reason
+		}
+	}
+}
+
+// parsePragma returns the pragma written in a comment, if any.
+func parsePragma(c models.CommentInfo) (pragma, bool) {
+	match := pragmaPattern.FindStringSubmatch(c.Text)
+	if match == nil {
+		return pragma{}, false
+	}
+	reason := strings.TrimSpace(match[2])
+	for _, closer := range []string{"*/", "-->"} {
+		reason = strings.TrimSpace(strings.TrimSuffix(reason, closer))
+	}
+	reason = strings.TrimSpace(strings.TrimLeft(reason, ":-—"))
+	return pragma{kind: match[1], reason: reason, firstLine: c.LineNumber, lastLine: c.LastLine()}, true
+}
+
+// suppressingPragma finds the pragma, if any, that suppresses a non-pragma comment.
+// Same-line and next-line pragmas take precedence over disabled regions.
+func suppressingPragma(c models.CommentInfo, pragmas []pragma) (pragma, bool) {
+	var region *pragma
+	for i, p := range pragmas {
+		switch p.kind {
+		case PragmaAllow:
+			if p.firstLine <= c.LastLine() && c.LineNumber <= p.lastLine {
+				return p, true
+			}
+		case PragmaAllowNextLine:
+			if c.LineNumber == p.lastLine+1 {
+				return p, true
+			}
+		case PragmaDisable:
+			if p.lastLine < c.LineNumber {
+				region = &pragmas[i]
+			}
+		case PragmaEnable:
+			if p.lastLine < c.LineNumber {
+				region = nil
+			}
+		}
+	}
+	if region != nil {
+		return *region, true
+	}
+	return pragma{}, false
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func pragmas(code, filePath string) map[string][2]string {
+	result := make(map[string][2]string)
+	for _, c := range NewCommentDetector().Detect(code, filePath, true) {
+		result[c.Text] = [2]string{c.Metadata[models.MetadataPragma], c.Metadata[models.MetadataPragmaReason]}
+	}
+	return result
+}
+
+func Test_Detect_AllowPragmas_SuppressSameAndNextLine(t *testing.T) {
+	// given
+	code := "const k = 1 /* constant time */ // comment-checker: allow SEC-12\n\n// comment-checker: allow-next-line\n// crypto rationale\n// flagged\n"
+
+	// when
+	result := pragmas(code, "main.go")
+
+	// then
+	assert.Equal(t, [2]string{"allow", "SEC-12"}, result["/* constant time */"])
+	assert.Equal(t, [2]string{"allow", "SEC-12"}, result["// comment-checker: allow SEC-12"])
+	assert.Equal(t, [2]string{"allow-next-line", ""}, result["// crypto rationale"])
+	assert.Equal(t, [2]string{"", ""}, result["// flagged"])
+}
+
+func Test_Detect_DisableEnablePragmas_SuppressRegion(t *testing.T) {
+	// given
+	code := "# before\n# comment-checker: disable -- vendored\n# inside\nx = \"# comment-checker: enable\"\n# still inside\n# comment-checker: enable\n# after\n"
+
+	// when
+	result := pragmas(code, "main.py")
+
+	// then
+	assert.Equal(t, [2]string{"", ""}, result["# before"])
+	assert.Equal(t, [2]string{"disable", "vendored"}, result["# inside"])
+	assert.Equal(t, [2]string{"disable", "vendored"}, result["# still inside"])
+	assert.Equal(t, [2]string{"enable", ""}, result["# comment-checker: enable"])
+	assert.Equal(t, [2]string{"", ""}, result["# after"])
+}
+
+func Test_Detect_BlockPragma_WorksInOtherLanguages(t *testing.T) {
+	// given
+	code := "<!-- comment-checker: allow-next-line -->\n<!-- legal notice -->\n<p>hi</p>\n"
+
+	// when
+	result := pragmas(code, "index.html")
+
+	// then
+	assert.Equal(t, [2]string{"allow-next-line", ""}, result["<!-- legal notice -->"])
+}
+
+func Test_Detect_PragmaInStringLiteral_SuppressesNothing(t *testing.T) {
+	// given
+	code := "def f():\n    \"\"\"comment-checker: disable\"\"\"\n    # inside\n    return 1\n\n# after\n"
+
+	// when
+	result := pragmas(code, "main.py")
+
+	// then
+	assert.Equal(t, [2]string{"", ""}, result["\"\"\"comment-checker: disable\"\"\""])
+	assert.Equal(t, [2]string{"", ""}, result["# inside"])
+	assert.Equal(t, [2]string{"", ""}, result["# after"])
+}
+
+func Test_Detect_AllowNextLinePragma_SuppressesDocstring(t *testing.T) {
+	// given
+	code := "def f():\n    # comment-checker: allow-next-line\n    \"\"\"Return one.\"\"\"\n    return 1\n"
+
+	// when
+	result := pragmas(code, "main.py")
+
+	// then
+	assert.Equal(t, [2]string{"allow-next-line", ""}, result["\"\"\"Return one.\"\"\""])
+}
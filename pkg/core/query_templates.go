@@ -11,7 +11,10 @@ var QueryTemplates = map[string]string{
 		(line_comment) @comment
 		(block_comment) @comment
 	`,
-	"swift": "(comment) @comment",
+	"swift": `
+		(comment) @comment
+		(multiline_comment) @comment
+	`,
 	"kotlin": `
 		(line_comment) @comment
 		(multiline_comment) @comment
@@ -43,27 +46,47 @@ var QueryTemplates = map[string]string{
 	"protobuf": "(comment) @comment",
 }
 
-// DocstringQueries maps language names to their tree-sitter docstring query patterns.
+// DocstringQueries maps language names to tree-sitter queries for docstrings
+// that are not comment nodes, such as Python's string-literal docstrings.
 var DocstringQueries = map[string]string{
 	"python": `
-		(module . (expression_statement (string) @docstring))
-		(class_definition body: (block . (expression_statement (string) @docstring)))
-		(function_definition body: (block . (expression_statement (string) @docstring)))
-	`,
-	"javascript": `
-		(comment) @jsdoc
-		(#match? @jsdoc "^/\\*\\*")
+		(module . (comment)* . (expression_statement (string) @docstring))
+		(class_definition body: (block . (comment)* . (expression_statement (string) @docstring)))
+		(function_definition body: (block . (comment)* . (expression_statement (string) @docstring)))
 	`,
-	"typescript": `
-		(comment) @jsdoc
-		(#match? @jsdoc "^/\\*\\*")
-	`,
-	"tsx": `
-		(comment) @jsdoc
-		(#match? @jsdoc "^/\\*\\*")
-	`,
-	"java": `
-		(comment) @javadoc
-		(#match? @javadoc "^/\\*\\*")
+	"elixir": `
+		(unary_operator
+			operand: (call
+				target: (identifier) @_attribute
+				(arguments [(string) (sigil)]))
+			(#match? @_attribute "^(moduledoc|doc|typedoc)$")) @docstring
 	`,
 }
+
+// StringCommentQueries maps language names to tree-sitter queries for string
+// literals used as comments, such as Python bare string statements that are not
+// docstrings. Matches that are also docstrings are skipped.
+var StringCommentQueries = map[string]string{
+	"python": "(expression_statement . (string) @string_comment .)",
+}
+
+// DocCommentPrefixes maps language names to the prefixes that mark a comment
+// as documentation (JSDoc, Javadoc, KDoc, PHPDoc, rustdoc, C# XML docs, ...).
+var DocCommentPrefixes = map[string][]string{
+	"javascript": {"/**"},
+	"typescript": {"/**"},
+	"tsx":        {"/**"},
+	"java":       {"/**"},
+	"kotlin":     {"/**"},
+	"php":        {"/**"},
+	"rust":       {"///", "//!", "/**", "/*!"},
+	"csharp":     {"///", "/**"},
+	"swift":      {"///", "/**"},
+}
+
+// DeclarationDocLanguages lists languages whose doc comments are ordinary
+// comments placed directly above a declaration (Go doc comments, RDoc/YARD).
+var DeclarationDocLanguages = map[string]struct{}{
+	"golang": {},
+	"ruby":   {},
+}
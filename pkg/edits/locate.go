@@ -0,0 +1,101 @@
+// Package edits maps Claude Code edit payloads onto the files they modify.
+package edits
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// LineOriginSnippet marks comments whose positions are relative to the edit
+// snippet because the snippet could not be located in the file.
+const LineOriginSnippet = "snippet"
+
+// Locate returns the byte offset of snippet in content.
+// Returns false if the snippet is empty, missing or occurs more than once.
+func Locate(content, snippet string) (int, bool) {
+	if snippet == "" {
+		return 0, false
+	}
+	offset := strings.Index(content, snippet)
+	if offset < 0 || strings.Contains(content[offset+1:], snippet) {
+		return 0, false
+	}
+	return offset, true
+}
+
+// Relocate translates the positions of comments detected in snippet into
+// positions in content. If the snippet cannot be located unambiguously, the
+// comments keep their snippet-relative positions and are marked with
+// models.MetadataLineOrigin set to LineOriginSnippet.
+func Relocate(comments []models.CommentInfo, content, snippet string) []models.CommentInfo {
+	offset, ok := Locate(content, snippet)
+
+	relocated := make([]models.CommentInfo, 0, len(comments))
+	for _, c := range comments {
+		if !ok {
+			relocated = append(relocated, c.WithMetadata(models.MetadataLineOrigin, LineOriginSnippet))
+			continue
+		}
+		relocated = append(relocated, shift(c, content, offset))
+	}
+	return relocated
+}
+
+// RelocateAll translates the positions of comments detected in snippet into
+// positions at every occurrence of snippet in content, as a ReplaceAll edit
+// copies them. If the snippet is not in content, the comments keep their
+// snippet-relative positions and are marked like in Relocate.
+func RelocateAll(comments []models.CommentInfo, content, snippet string) []models.CommentInfo {
+	var offsets []int
+	for start := 0; snippet != ""; {
+		i := strings.Index(content[start:], snippet)
+		if i < 0 {
+			break
+		}
+		offsets = append(offsets, start+i)
+		start += i + len(snippet)
+	}
+	if len(offsets) == 0 {
+		return Relocate(comments, "", snippet)
+	}
+
+	relocated := make([]models.CommentInfo, 0, len(comments)*len(offsets))
+	for _, offset := range offsets {
+		for _, c := range comments {
+			relocated = append(relocated, shift(c, content, offset))
+		}
+	}
+	return relocated
+}
+
+// shift moves a snippet-relative comment to the snippet's offset in content.
+func shift(c models.CommentInfo, content string, offset int) models.CommentInfo {
+	prefix := content[:offset]
+	lineDelta := strings.Count(prefix, "\n")
+	lastLine := prefix[strings.LastIndex(prefix, "\n")+1:]
+	columnDelta := len(lastLine)
+	runeColumnDelta := utf8.RuneCountInString(lastLine)
+
+	// Only the snippet's first line starts mid-line in the file
+	if c.LineNumber == 1 {
+		c.Column += columnDelta
+		if c.RuneColumn > 0 {
+			c.RuneColumn += runeColumnDelta
+		}
+	}
+	if c.LastLine() == 1 && c.EndColumn > 0 {
+		c.EndColumn += columnDelta
+		if c.RuneEndColumn > 0 {
+			c.RuneEndColumn += runeColumnDelta
+		}
+	}
+	c.LineNumber += lineDelta
+	if c.EndLine > 0 {
+		c.EndLine += lineDelta
+	}
+	c.StartByte += offset
+	c.EndByte += offset
+	return c
+}
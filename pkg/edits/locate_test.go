@@ -0,0 +1,108 @@
+package edits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_Locate_UniqueSnippet_ReturnsOffset(t *testing.T) {
+	// given
+	content := "a\nb\nc\n"
+
+	// when
+	offset, ok := Locate(content, "b\n")
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, 2, offset)
+}
+
+func Test_Locate_AmbiguousOrMissingSnippet_ReturnsFalse(t *testing.T) {
+	// given
+	content := "x = 1\nx = 1\n"
+
+	// when
+	_, ambiguous := Locate(content, "x = 1")
+	_, missing := Locate(content, "y = 2")
+	_, empty := Locate(content, "")
+
+	// then
+	assert.False(t, ambiguous)
+	assert.False(t, missing)
+	assert.False(t, empty)
+}
+
+func Test_Relocate_SnippetInFile_TranslatesToFilePositions(t *testing.T) {
+	// given
+	snippet := "y = 2  # set y\n# next\n"
+	content := "import os\n\nx = 1; " + snippet + "print(y)\n"
+	comments := core.NewCommentDetector().Detect(snippet, "app.py", false)
+	require.Len(t, comments, 2)
+
+	// when
+	result := Relocate(comments, content, snippet)
+
+	// then
+	require.Len(t, result, 2)
+	assert.Equal(t, 3, result[0].LineNumber)
+	assert.Equal(t, 15, result[0].Column)
+	assert.Equal(t, 22, result[0].EndColumn)
+	assert.Equal(t, "# set y", content[result[0].StartByte:result[0].EndByte])
+	assert.Equal(t, 4, result[1].LineNumber)
+	assert.Equal(t, 1, result[1].Column)
+	assert.Equal(t, "# next", content[result[1].StartByte:result[1].EndByte])
+	assert.Empty(t, result[1].Metadata[models.MetadataLineOrigin])
+}
+
+func Test_Relocate_NonASCIIPrefix_ShiftsRuneColumns(t *testing.T) {
+	// given
+	snippet := "y = 2  # set y\n"
+	content := "s = \"한글\"; " + snippet
+	comments := core.NewCommentDetector().Detect(snippet, "app.py", false)
+	require.Len(t, comments, 1)
+
+	// when
+	result := Relocate(comments, content, snippet)
+
+	// then
+	require.Len(t, result, 1)
+	assert.Equal(t, 22, result[0].Column)
+	assert.Equal(t, 18, result[0].RuneColumn)
+	assert.Equal(t, 25, result[0].RuneEndColumn)
+}
+
+func Test_RelocateAll_ReportsCommentAtEveryOccurrence(t *testing.T) {
+	// given
+	snippet := "f()  # call"
+	content := "x = 1\n" + snippet + "\n" + snippet + "\n"
+	comments := core.NewCommentDetector().Detect(snippet, "app.py", false)
+	require.Len(t, comments, 1)
+
+	// when
+	result := RelocateAll(comments, content, snippet)
+
+	// then
+	require.Len(t, result, 2)
+	assert.Equal(t, 2, result[0].LineNumber)
+	assert.Equal(t, 3, result[1].LineNumber)
+	assert.Equal(t, "# call", content[result[1].StartByte:result[1].EndByte])
+}
+
+func Test_Relocate_SnippetNotInFile_MarksSnippetOrigin(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{{Text: "# note", LineNumber: 1}}
+
+	// when
+	result := Relocate(comments, "", "# note\n")
+
+	// then
+	require.Len(t, result, 1)
+	assert.Equal(t, 1, result[0].LineNumber)
+	assert.Equal(t, LineOriginSnippet, result[0].Metadata[models.MetadataLineOrigin])
+	assert.Nil(t, comments[0].Metadata)
+}
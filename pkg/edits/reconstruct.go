@@ -0,0 +1,85 @@
+package edits
+
+import "strings"
+
+// Edit is a single old_string → new_string replacement from an Edit or MultiEdit payload.
+// ReplaceAll replaces every occurrence of OldString instead of a unique one.
+type Edit struct {
+	OldString  string
+	NewString  string
+	ReplaceAll bool
+}
+
+// Apply applies edits in order, each to the result of the previous one, the way
+// Claude Code's MultiEdit does. Returns false if an edit's OldString is missing,
+// or is ambiguous for an edit without ReplaceAll. An empty OldString is only
+// valid on empty content, where it creates the file.
+func Apply(content string, edits []Edit) (string, bool) {
+	for _, edit := range edits {
+		if edit.OldString == "" {
+			if content != "" {
+				return "", false
+			}
+			content = edit.NewString
+			continue
+		}
+		if edit.ReplaceAll {
+			if !strings.Contains(content, edit.OldString) {
+				return "", false
+			}
+			content = strings.ReplaceAll(content, edit.OldString, edit.NewString)
+			continue
+		}
+
+		offset, ok := Locate(content, edit.OldString)
+		if !ok {
+			return "", false
+		}
+		content = replaceAt(content, offset, len(edit.OldString), edit.NewString)
+	}
+	return content, true
+}
+
+// Revert undoes already applied edits, last to first. Edits that deleted text
+// (empty NewString) cannot be located and are skipped: the removed text is
+// not needed to find comments the edits introduced. Returns false if another
+// edit's NewString is missing or ambiguous, or for any ReplaceAll edit: the
+// file may have contained NewString before, and those occurrences must not
+// be reverted.
+func Revert(content string, edits []Edit) (string, bool) {
+	for i := len(edits) - 1; i >= 0; i-- {
+		edit := edits[i]
+		if edit.ReplaceAll {
+			return "", false
+		}
+		if edit.NewString == "" {
+			continue
+		}
+
+		offset, ok := Locate(content, edit.NewString)
+		if !ok {
+			return "", false
+		}
+		content = replaceAt(content, offset, len(edit.NewString), edit.OldString)
+	}
+	return content, true
+}
+
+// Reconstruct rebuilds the full file content before and after a sequence of
+// edits from the content currently on disk. When applied is true the edits
+// have already been written (PostToolUse) and onDisk is the after state;
+// otherwise (PreToolUse) onDisk is the before state. Returns false if the
+// edits cannot be replayed against onDisk.
+func Reconstruct(onDisk string, edits []Edit, applied bool) (before, after string, ok bool) {
+	if applied {
+		before, ok = Revert(onDisk, edits)
+		return before, onDisk, ok
+	}
+	after, ok = Apply(onDisk, edits)
+	return onDisk, after, ok
+}
+
+// replaceAt replaces length bytes of s starting at offset with replacement.
+func replaceAt(s string, offset, length int, replacement string) string {
+	return s[:offset] + replacement + s[offset+length:]
+}
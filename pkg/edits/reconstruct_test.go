@@ -0,0 +1,145 @@
+package edits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Reconstruct_Applied_RevertsEditForBefore(t *testing.T) {
+	// given
+	onDisk := "def f():\n    # explain\n    return 1\n"
+	edit := Edit{OldString: "    return 0", NewString: "    # explain\n    return 1"}
+
+	// when
+	before, after, ok := Reconstruct(onDisk, []Edit{edit}, true)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "def f():\n    return 0\n", before)
+	assert.Equal(t, onDisk, after)
+}
+
+func Test_Reconstruct_NotApplied_AppliesEditForAfter(t *testing.T) {
+	// given
+	onDisk := "def f():\n    return 0\n"
+	edit := Edit{OldString: "    return 0", NewString: "    # explain\n    return 1"}
+
+	// when
+	before, after, ok := Reconstruct(onDisk, []Edit{edit}, false)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, onDisk, before)
+	assert.Equal(t, "def f():\n    # explain\n    return 1\n", after)
+}
+
+func Test_Reconstruct_TextNotOnDisk_ReturnsFalse(t *testing.T) {
+	// given
+	edits := []Edit{{OldString: "a", NewString: "b"}}
+
+	// when
+	_, _, applied := Reconstruct("", edits, true)
+	_, _, pending := Reconstruct("c", edits, false)
+
+	// then
+	assert.False(t, applied)
+	assert.False(t, pending)
+}
+
+func Test_Apply_EditsAppliedInSequence(t *testing.T) {
+	// given
+	content := "a = 1\n"
+	edits := []Edit{
+		{OldString: "a = 1", NewString: "a = 1  # temp"},
+		{OldString: "  # temp", NewString: ""},
+		{OldString: "a = 1", NewString: "a = 2"},
+	}
+
+	// when
+	result, ok := Apply(content, edits)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "a = 2\n", result)
+}
+
+func Test_Apply_ReplaceAll_ReplacesEveryOccurrence(t *testing.T) {
+	// given
+	content := "f()\nf()\n"
+	edits := []Edit{{OldString: "f()", NewString: "f()  # call", ReplaceAll: true}}
+
+	// when
+	result, ok := Apply(content, edits)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "f()  # call\nf()  # call\n", result)
+}
+
+func Test_Apply_AmbiguousWithoutReplaceAll_ReturnsFalse(t *testing.T) {
+	// given
+	edits := []Edit{{OldString: "f()", NewString: "g()"}}
+
+	// when
+	_, ok := Apply("f()\nf()\n", edits)
+
+	// then
+	assert.False(t, ok)
+}
+
+func Test_Apply_EmptyOldStringOnEmptyFile_CreatesContent(t *testing.T) {
+	// given
+	edits := []Edit{{OldString: "", NewString: "x = 1\n"}}
+
+	// when
+	result, ok := Apply("", edits)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "x = 1\n", result)
+}
+
+func Test_Revert_UndoesEditsLastToFirst(t *testing.T) {
+	// given
+	content := "b = 3  # three\nc = 1\n"
+	edits := []Edit{
+		{OldString: "a = 1", NewString: "b = 2"},
+		{OldString: "b = 2", NewString: "b = 3  # three"},
+	}
+
+	// when
+	result, ok := Revert(content, edits)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "a = 1\nc = 1\n", result)
+}
+
+func Test_Revert_ReplaceAll_ReturnsFalse(t *testing.T) {
+	// given
+	content := "// total is reported by the caller\ntotal += 1\nreturn total\n"
+	edits := []Edit{{OldString: "acc", NewString: "total", ReplaceAll: true}}
+
+	// when
+	_, ok := Revert(content, edits)
+
+	// then
+	assert.False(t, ok)
+}
+
+func Test_Revert_DeletionEdit_IsSkipped(t *testing.T) {
+	// given
+	content := "x = 1\n# added\n"
+	edits := []Edit{
+		{OldString: "# old\n", NewString: ""},
+		{OldString: "x = 1\n", NewString: "x = 1\n# added\n"},
+	}
+
+	// when
+	result, ok := Revert(content, edits)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "x = 1\n", result)
+}
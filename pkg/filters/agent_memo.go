@@ -3,56 +3,149 @@ package filters
 import (
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
 )
 
-var agentMemoPatterns = []*regexp.Regexp{
-	regexp.MustCompile(`(?i)^[\s#/*-]*changed?\s+(from|to)\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*modified?\s+(from|to)?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*updated?\s+(from|to)?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*refactor(ed|ing)?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*moved?\s+(from|to)\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*renamed?\s+(from|to)?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*replaced?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*removed?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*deleted?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*added?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*implemented?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*this\s+(implements?|adds?|removes?|changes?|fixes?)\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*here\s+we\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*now\s+(we|this|it)\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*previously\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*before\s+this\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*after\s+this\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*was\s+changed\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*implementation\s+(of|note)\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*note:\s*\w`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*[a-z]+\s*->\s*[a-z]+`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*converted?\s+(from|to)\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*migrated?\s+(from|to)?\b`),
-	regexp.MustCompile(`(?i)^[\s#/*-]*switched?\s+(from|to)\b`),
-
-	regexp.MustCompile(`(?i)여기(서|에서)\s*`),
-	regexp.MustCompile(`(?i)(으로|로)\s*(바뀜|변경|변환)`),
-	regexp.MustCompile(`(?i)구현(임|함|했|된|됨)`),
-	regexp.MustCompile(`(?i)추가(함|했|된|됨)`),
-	regexp.MustCompile(`(?i)삭제(함|했|된|됨)`),
-	regexp.MustCompile(`(?i)수정(함|했|된|됨)`),
-	regexp.MustCompile(`(?i)변경(함|했|된|됨)`),
-	regexp.MustCompile(`(?i)리팩(터|토)링`),
-	regexp.MustCompile(`(?i)이전(에는|엔)`),
-	regexp.MustCompile(`(?i)기존(에는|엔|의)`),
-	regexp.MustCompile(`(?i)에서\s+\S+\s*(으로|로)\b`),
-}
-
-type AgentMemoFilter struct{}
+// agentMemoPattern is a named pattern recognizing memo-style comments.
+type agentMemoPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// Languages of the built-in agent memo pattern packs.
+const (
+	AgentMemoEnglish    = "en"
+	AgentMemoKorean     = "ko"
+	AgentMemoJapanese   = "ja"
+	AgentMemoChinese    = "zh"
+	AgentMemoSpanish    = "es"
+	AgentMemoGerman     = "de"
+	AgentMemoPortuguese = "pt"
+)
+
+// AgentMemoLanguages lists the languages with a pattern pack, in match order.
+var AgentMemoLanguages = []string{
+	AgentMemoEnglish,
+	AgentMemoKorean,
+	AgentMemoJapanese,
+	AgentMemoChinese,
+	AgentMemoSpanish,
+	AgentMemoGerman,
+	AgentMemoPortuguese,
+}
+
+// DefaultAgentMemoLanguages contains the pattern packs enabled by default.
+var DefaultAgentMemoLanguages = map[string]struct{}{
+	AgentMemoEnglish: {},
+	AgentMemoKorean:  {},
+}
+
+// agentMemoPacks maps each language to its patterns. Within a pack patterns
+// are matched in order; the first match names the verdict.
+var agentMemoPacks = map[string][]agentMemoPattern{
+	AgentMemoEnglish:    englishMemoPatterns,
+	AgentMemoKorean:     koreanMemoPatterns,
+	AgentMemoJapanese:   japaneseMemoPatterns,
+	AgentMemoChinese:    chineseMemoPatterns,
+	AgentMemoSpanish:    spanishMemoPatterns,
+	AgentMemoGerman:     germanMemoPatterns,
+	AgentMemoPortuguese: portugueseMemoPatterns,
+}
+
+// scriptLanguages maps the script of a comment to the packs written in it.
+// Han is shared by Chinese and Japanese comments written without kana.
+var scriptLanguages = map[*unicode.RangeTable][]string{
+	unicode.Hangul: {AgentMemoKorean},
+	unicode.Han:    {AgentMemoChinese, AgentMemoJapanese},
+	unicode.Latin:  {AgentMemoEnglish, AgentMemoSpanish, AgentMemoGerman, AgentMemoPortuguese},
+	kana:           {AgentMemoJapanese},
+}
 
+// kana combines the Hiragana and Katakana scripts.
+var kana = &unicode.RangeTable{
+	R16: append(append([]unicode.Range16(nil), unicode.Hiragana.R16...), unicode.Katakana.R16...),
+	R32: append(append([]unicode.Range32(nil), unicode.Hiragana.R32...), unicode.Katakana.R32...),
+}
+
+var englishMemoPatterns = []agentMemoPattern{
+	{"changed-from-to", regexp.MustCompile(`(?i)^[\s#/*-]*changed?\s+(from|to)\b`)},
+	{"modified", regexp.MustCompile(`(?i)^[\s#/*-]*modified?\s+(from|to)?\b`)},
+	{"updated", regexp.MustCompile(`(?i)^[\s#/*-]*updated?\s+(from|to)?\b`)},
+	{"refactored", regexp.MustCompile(`(?i)^[\s#/*-]*refactor(ed|ing)?\b`)},
+	{"moved", regexp.MustCompile(`(?i)^[\s#/*-]*moved?\s+(from|to)\b`)},
+	{"renamed", regexp.MustCompile(`(?i)^[\s#/*-]*renamed?\s+(from|to)?\b`)},
+	{"replaced", regexp.MustCompile(`(?i)^[\s#/*-]*replaced?\b`)},
+	{"removed", regexp.MustCompile(`(?i)^[\s#/*-]*removed?\b`)},
+	{"deleted", regexp.MustCompile(`(?i)^[\s#/*-]*deleted?\b`)},
+	{"added", regexp.MustCompile(`(?i)^[\s#/*-]*added?\b`)},
+	{"implemented", regexp.MustCompile(`(?i)^[\s#/*-]*implemented?\b`)},
+	{"this-changes", regexp.MustCompile(`(?i)^[\s#/*-]*this\s+(implements?|adds?|removes?|changes?|fixes?)\b`)},
+	{"here-we", regexp.MustCompile(`(?i)^[\s#/*-]*here\s+we\b`)},
+	{"now-we", regexp.MustCompile(`(?i)^[\s#/*-]*now\s+(we|this|it)\b`)},
+	{"previously", regexp.MustCompile(`(?i)^[\s#/*-]*previously\b`)},
+	{"before-this", regexp.MustCompile(`(?i)^[\s#/*-]*before\s+this\b`)},
+	{"after-this", regexp.MustCompile(`(?i)^[\s#/*-]*after\s+this\b`)},
+	{"was-changed", regexp.MustCompile(`(?i)^[\s#/*-]*was\s+changed\b`)},
+	{"implementation-of", regexp.MustCompile(`(?i)^[\s#/*-]*implementation\s+(of|note)\b`)},
+	{"note", regexp.MustCompile(`(?i)^[\s#/*-]*note:\s*\w`)},
+	{"arrow", regexp.MustCompile(`(?i)^[\s#/*-]*[a-z]+\s*->\s*[a-z]+`)},
+	{"converted", regexp.MustCompile(`(?i)^[\s#/*-]*converted?\s+(from|to)\b`)},
+	{"migrated", regexp.MustCompile(`(?i)^[\s#/*-]*migrated?\s+(from|to)?\b`)},
+	{"switched", regexp.MustCompile(`(?i)^[\s#/*-]*switched?\s+(from|to)\b`)},
+}
+
+var koreanMemoPatterns = []agentMemoPattern{
+	{"ko-here", regexp.MustCompile(`(?i)여기(서|에서)\s*`)},
+	{"ko-changed-to", regexp.MustCompile(`(?i)(으로|로)\s*(바뀜|변경|변환)`)},
+	{"ko-implemented", regexp.MustCompile(`(?i)구현(임|함|했|된|됨)`)},
+	{"ko-added", regexp.MustCompile(`(?i)추가(함|했|된|됨)`)},
+	{"ko-deleted", regexp.MustCompile(`(?i)삭제(함|했|된|됨)`)},
+	{"ko-modified", regexp.MustCompile(`(?i)수정(함|했|된|됨)`)},
+	{"ko-changed", regexp.MustCompile(`(?i)변경(함|했|된|됨)`)},
+	{"ko-refactoring", regexp.MustCompile(`(?i)리팩(터|토)링`)},
+	{"ko-previously", regexp.MustCompile(`(?i)이전(에는|엔)`)},
+	{"ko-existing", regexp.MustCompile(`(?i)기존(에는|엔|의)`)},
+	{"ko-from-to", regexp.MustCompile(`(?i)에서\s+\S+\s*(으로|로)\b`)},
+}
+
+// AgentMemoFilter recognizes memo-style comments that describe what was
+// changed instead of what the code does.
+type AgentMemoFilter struct {
+	languages    []string
+	detectScript bool
+}
+
+// NewAgentMemoFilter creates a new AgentMemoFilter using the default
+// DefaultAgentMemoLanguages pattern packs.
 func NewAgentMemoFilter() *AgentMemoFilter {
-	return &AgentMemoFilter{}
+	return NewAgentMemoFilterWithLanguages(DefaultAgentMemoLanguages, false)
+}
+
+// NewAgentMemoFilterWithLanguages creates a new AgentMemoFilter with the pattern
+// packs of the given languages. Unknown languages are ignored. If detectScript
+// is true, a comment is only matched against the packs written in its script:
+// Hangul, Han, Kana or Latin.
+func NewAgentMemoFilterWithLanguages(languages map[string]struct{}, detectScript bool) *AgentMemoFilter {
+	f := &AgentMemoFilter{detectScript: detectScript}
+	for _, lang := range AgentMemoLanguages {
+		if _, ok := languages[lang]; ok {
+			f.languages = append(f.languages, lang)
+		}
+	}
+	return f
 }
 
+// IsAgentMemo returns true if the comment matches any agent memo pattern.
 func (f *AgentMemoFilter) IsAgentMemo(comment models.CommentInfo) bool {
+	_, ok := f.Match(comment)
+	return ok
+}
+
+// Match returns the name of the first agent memo pattern the comment matches.
+// Returns false if the comment is not an agent memo.
+func (f *AgentMemoFilter) Match(comment models.CommentInfo) (string, bool) {
 	text := strings.TrimSpace(comment.Text)
 
 	for _, prefix := range []string{"#", "//", "/*", "--", "*"} {
@@ -61,11 +154,92 @@ func (f *AgentMemoFilter) IsAgentMemo(comment models.CommentInfo) bool {
 		}
 	}
 
-	for _, pattern := range agentMemoPatterns {
-		if pattern.MatchString(text) {
-			return true
+	languages := f.languages
+	if f.detectScript {
+		languages = f.languagesForScript(text)
+	}
+	for _, lang := range languages {
+		for _, pattern := range agentMemoPacks[lang] {
+			if pattern.re.MatchString(text) {
+				return pattern.name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// languagesForScript returns the enabled packs written in the script of text.
+// Kana takes precedence over Han, which is shared with Japanese, and any
+// non-Latin script over Latin, since code identifiers are usually Latin.
+// Text without letters of a known script is matched against every enabled pack.
+func (f *AgentMemoFilter) languagesForScript(text string) []string {
+	script := detectScript(text)
+	if script == nil {
+		return f.languages
+	}
+
+	var languages []string
+	for _, lang := range f.languages {
+		for _, candidate := range scriptLanguages[script] {
+			if lang == candidate {
+				languages = append(languages, lang)
+			}
+		}
+	}
+	return languages
+}
+
+// detectScript returns the script a comment is written in, or nil if it has
+// no letters of a known script.
+func detectScript(text string) *unicode.RangeTable {
+	var hasHangul, hasHan, hasLatin bool
+	for _, r := range text {
+		switch {
+		case unicode.Is(kana, r):
+			return kana
+		case unicode.Is(unicode.Hangul, r):
+			hasHangul = true
+		case unicode.Is(unicode.Han, r):
+			hasHan = true
+		case unicode.Is(unicode.Latin, r):
+			hasLatin = true
 		}
 	}
 
-	return false
+	switch {
+	case hasHangul:
+		return unicode.Hangul
+	case hasHan:
+		return unicode.Han
+	case hasLatin:
+		return unicode.Latin
+	default:
+		return nil
+	}
+}
+
+// Annotate returns a copy of the comment carrying the agent memo verdict and,
+// for memos, the name of the matching pattern.
+func (f *AgentMemoFilter) Annotate(comment models.CommentInfo) models.CommentInfo {
+	name, ok := f.Match(comment)
+	if !ok {
+		return comment.WithMetadata(models.MetadataAgentMemo, "false")
+	}
+	memo := comment.WithMetadata(models.MetadataAgentMemo, "true")
+	return memo.WithMetadata(models.MetadataAgentMemoPattern, name)
+}
+
+// AgentMemoPattern returns the agent memo verdict recorded by Annotate and the
+// matching pattern's name. Comments without a verdict are matched against the
+// default pattern packs.
+func AgentMemoPattern(comment models.CommentInfo) (string, bool) {
+	switch comment.Metadata[models.MetadataAgentMemo] {
+	case "true":
+		return comment.Metadata[models.MetadataAgentMemoPattern], true
+	case "false":
+		return "", false
+	default:
+		return NewAgentMemoFilter().Match(comment)
+	}
 }
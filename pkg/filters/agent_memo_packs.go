@@ -0,0 +1,77 @@
+package filters
+
+import "regexp"
+
+// Go's \b only knows ASCII word characters, so patterns for words that may
+// end in an accented letter close with a space, colon or end of text instead.
+
+var japaneseMemoPatterns = []agentMemoPattern{
+	{"ja-changed", regexp.MustCompile(`変更(しました|した|済み|された|されました)`)},
+	{"ja-modified", regexp.MustCompile(`修正(しました|した|済み|された|されました)`)},
+	{"ja-added", regexp.MustCompile(`^[\s#/*-]*追加([:：\s]|$)|追加(しました|した|済み|された|されました)`)},
+	{"ja-deleted", regexp.MustCompile(`(削除|除去)(しました|した|済み|された|されました)`)},
+	{"ja-implemented", regexp.MustCompile(`実装(しました|した|済み|された|されました)`)},
+	{"ja-updated", regexp.MustCompile(`更新(しました|した|済み|された|されました)`)},
+	{"ja-refactored", regexp.MustCompile(`リファクタ(リング)?(しました|した|済み)`)},
+	{"ja-changed-to", regexp.MustCompile(`(に|へ)(変更|置き換え|置換|移行|切り替え)(しました|した|済み|。|$)`)},
+	{"ja-here", regexp.MustCompile(`ここで(変更|修正|追加|削除|実装)`)},
+	{"ja-previously", regexp.MustCompile(`(以前|元々|もともと)は`)},
+}
+
+var chineseMemoPatterns = []agentMemoPattern{
+	{"zh-changed-to", regexp.MustCompile(`(修改|更改|变更|變更|替换|替換|切换|切換|迁移|遷移|重构|重構|改)(为|為|成)`)},
+	{"zh-added", regexp.MustCompile(`^[\s#/*-]*(新增|添加)([:：\s]|$)|已(添加|增加|新增)`)},
+	{"zh-modified", regexp.MustCompile(`已(修改|更改|变更|變更|更新)`)},
+	{"zh-deleted", regexp.MustCompile(`已(删除|刪除|移除)`)},
+	{"zh-implemented", regexp.MustCompile(`已实现|已實現|实现了|實現了`)},
+	{"zh-refactored", regexp.MustCompile(`(重构|重構)(了|后|後)`)},
+	{"zh-from-to", regexp.MustCompile(`从\S+(改|换|迁移|切换)(为|成|到)`)},
+	{"zh-here", regexp.MustCompile(`(这里|這裡)(修改|改|添加|删除|刪除|更新)`)},
+	{"zh-previously", regexp.MustCompile(`(之前|以前|原来|原來)(是|为|為|用)`)},
+}
+
+var spanishMemoPatterns = []agentMemoPattern{
+	{"es-changed", regexp.MustCompile(`(?i)^[\s#/*-]*(se\s+|fue\s+)?(cambiad[oa]s?|cambi[éó]|modificad[oa]s?|modifiqu[ée]|modific[óo])(\s|:|$)`)},
+	{"es-added", regexp.MustCompile(`(?i)^[\s#/*-]*(se\s+|fue\s+)?(añadid[oa]s?|agregad[oa]s?|añad[íi]|añadi[óo]|agregu[ée]|agreg[óo])(\s|:|$)`)},
+	{"es-removed", regexp.MustCompile(`(?i)^[\s#/*-]*(se\s+|fue\s+)?(eliminad[oa]s?|borrad[oa]s?|quitad[oa]s?|elimin[éó]|borr[éó])(\s|:|$)`)},
+	{"es-refactored", regexp.MustCompile(`(?i)^[\s#/*-]*(se\s+|fue\s+)?(refactorizad[oa]s?|refactoric[ée]|refactoriz[óo])(\s|:|$)`)},
+	{"es-implemented", regexp.MustCompile(`(?i)^[\s#/*-]*(se\s+|fue\s+)?(implementad[oa]s?|implement[éó])(\s|:|$)`)},
+	{"es-updated", regexp.MustCompile(`(?i)^[\s#/*-]*(se\s+|fue\s+)?(actualizad[oa]s?|actualic[ée]|actualiz[óo])(\s|:|$)`)},
+	{"es-here", regexp.MustCompile(`(?i)^[\s#/*-]*aqu[íi]\s+(cambiamos|añadimos|agregamos|eliminamos|implementamos)(\s|:|$)`)},
+	{"es-now", regexp.MustCompile(`(?i)^[\s#/*-]*ahora\s+(se\s+usa|usamos|esto)(\s|:|$)`)},
+	{"es-previously", regexp.MustCompile(`(?i)^[\s#/*-]*(antes|anteriormente)\s+(era|se\s+usaba|usaba|estaba)(\s|:|$)`)},
+}
+
+// German participles usually close the sentence ("Von Liste zu Set geändert"),
+// so they are matched at either end of the comment.
+var germanMemoPatterns = []agentMemoPattern{
+	{"de-changed", germanParticiple(`geändert|angepasst|umgestellt|modifiziert`)},
+	{"de-added", germanParticiple(`hinzugefügt|ergänzt`)},
+	{"de-removed", germanParticiple(`entfernt|gelöscht`)},
+	{"de-replaced", germanParticiple(`ersetzt`)},
+	{"de-renamed", germanParticiple(`umbenannt`)},
+	{"de-refactored", germanParticiple(`refaktoriert|refactored|überarbeitet`)},
+	{"de-implemented", germanParticiple(`implementiert|umgesetzt`)},
+	{"de-updated", germanParticiple(`aktualisiert`)},
+	{"de-here", regexp.MustCompile(`(?i)^[\s#/*-]*hier\s+(haben\s+wir|wurden?)(\s|:|$)`)},
+	{"de-now", regexp.MustCompile(`(?i)^[\s#/*-]*(jetzt|nun)\s+(wird|werden|verwenden\s+wir|nutzen\s+wir)(\s|:|$)`)},
+	{"de-previously", regexp.MustCompile(`(?i)^[\s#/*-]*(vorher|früher|bisher|zuvor)\s+(war|wurde|wurden|haben)(\s|:|$)`)},
+}
+
+var portugueseMemoPatterns = []agentMemoPattern{
+	{"pt-changed", regexp.MustCompile(`(?i)^[\s#/*-]*(foi\s+|foram\s+)?(alterad[oa]s?|mudad[oa]s?|modificad[oa]s?|alterei|mudei|modifiquei)(\s|:|$)`)},
+	{"pt-added", regexp.MustCompile(`(?i)^[\s#/*-]*(foi\s+|foram\s+)?(adicionad[oa]s?|acrescentad[oa]s?|adicionei|acrescentei)(\s|:|$)`)},
+	{"pt-removed", regexp.MustCompile(`(?i)^[\s#/*-]*(foi\s+|foram\s+)?(removid[oa]s?|excluíd[oa]s?|apagad[oa]s?|removi|exclu[íi]|apaguei)(\s|:|$)`)},
+	{"pt-refactored", regexp.MustCompile(`(?i)^[\s#/*-]*(foi\s+|foram\s+)?(refatorad[oa]s?|refatorei)(\s|:|$)`)},
+	{"pt-implemented", regexp.MustCompile(`(?i)^[\s#/*-]*(foi\s+|foram\s+)?(implementad[oa]s?|implementei)(\s|:|$)`)},
+	{"pt-updated", regexp.MustCompile(`(?i)^[\s#/*-]*(foi\s+|foram\s+)?(atualizad[oa]s?|atualizei)(\s|:|$)`)},
+	{"pt-here", regexp.MustCompile(`(?i)^[\s#/*-]*aqui\s+(alteramos|mudamos|adicionamos|removemos|implementamos)(\s|:|$)`)},
+	{"pt-now", regexp.MustCompile(`(?i)^[\s#/*-]*agora\s+(usa|usamos|isso|isto)(\s|:|$)`)},
+	{"pt-previously", regexp.MustCompile(`(?i)^[\s#/*-]*(antes|anteriormente)\s+(era|usava|estava)(\s|:|$)`)},
+}
+
+// germanParticiple matches one of the participles at the start of a comment
+// or as its last word.
+func germanParticiple(participles string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)^[\s#/*-]*(` + participles + `)([\s:,]|$)|\s(` + participles + `)[.!]?$`)
+}
@@ -0,0 +1,336 @@
+package filters
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AgentMemoFilter_IsAgentMemo_Japanese_HenkouShimashita(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoJapanese: {}}, false)
+	comment := models.CommentInfo{Text: "// リストからセットに変更しました"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Japanese_Tsuika(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoJapanese: {}}, false)
+	comment := models.CommentInfo{Text: "// 追加: リトライ処理"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Japanese_ShuuseiZumi(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoJapanese: {}}, false)
+	comment := models.CommentInfo{Text: "# 境界値のバグを修正済み"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Japanese_IzenWa(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoJapanese: {}}, false)
+	comment := models.CommentInfo{Text: "// 以前はグローバル変数を使っていた"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Chinese_XiugaiWei(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoChinese: {}}, false)
+	comment := models.CommentInfo{Text: "// 修改为使用哈希表"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Chinese_YiTianjia(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoChinese: {}}, false)
+	comment := models.CommentInfo{Text: "# 已添加重试逻辑"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Chinese_CongDao(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoChinese: {}}, false)
+	comment := models.CommentInfo{Text: "// 从列表改为集合"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Spanish_SeCambio(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoSpanish: {}}, false)
+	comment := models.CommentInfo{Text: "// Se cambió de lista a conjunto"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Spanish_Anadido(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoSpanish: {}}, false)
+	comment := models.CommentInfo{Text: "# Añadido manejo de errores"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Spanish_Refactorizado(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoSpanish: {}}, false)
+	comment := models.CommentInfo{Text: "// Refactorizado para usar un mapa"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_German_GeaendertAtEnd(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoGerman: {}}, false)
+	comment := models.CommentInfo{Text: "// Von Liste zu Set geändert"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_German_Hinzugefuegt(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoGerman: {}}, false)
+	comment := models.CommentInfo{Text: "# Hinzugefügt: Wiederholungslogik"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_German_Entfernt(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoGerman: {}}, false)
+	comment := models.CommentInfo{Text: "// Entfernt, weil nicht mehr benötigt"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Portuguese_FoiAlterado(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoPortuguese: {}}, false)
+	comment := models.CommentInfo{Text: "// Foi alterado para usar um mapa"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Portuguese_Adicionado(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoPortuguese: {}}, false)
+	comment := models.CommentInfo{Text: "# Adicionado tratamento de erros"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_Portuguese_Refatorado(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoPortuguese: {}}, false)
+	comment := models.CommentInfo{Text: "// Refatorado para simplificar"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_NotAgentMemo_RegularJapanese(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoJapanese: {}}, false)
+	comment := models.CommentInfo{Text: "// 追加のオプションを渡す"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.False(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_NotAgentMemo_RegularChinese(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoChinese: {}}, false)
+	comment := models.CommentInfo{Text: "// 计算所有值的总和"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.False(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_NotAgentMemo_RegularSpanish(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoSpanish: {}}, false)
+	comment := models.CommentInfo{Text: "// Calcula la suma de los valores"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.False(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_NotAgentMemo_RegularGerman(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoGerman: {}}, false)
+	comment := models.CommentInfo{Text: "// Berechnet die Summe der Werte"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.False(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_NotAgentMemo_RegularPortuguese(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoPortuguese: {}}, false)
+	comment := models.CommentInfo{Text: "// Calcula a soma dos valores"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.False(t, result)
+}
+
+func Test_AgentMemoFilter_IsAgentMemo_DefaultPacks_IgnoreOtherLanguages(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilter()
+	comment := models.CommentInfo{Text: "// リストからセットに変更しました"}
+
+	// when
+	result := filter.IsAgentMemo(comment)
+
+	// then
+	assert.False(t, result)
+}
+
+func Test_AgentMemoFilter_Match_MultiplePacks_NamesPackPattern(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilterWithLanguages(map[string]struct{}{AgentMemoEnglish: {}, AgentMemoChinese: {}, AgentMemoGerman: {}}, false)
+
+	// when
+	zhName, zhOK := filter.Match(models.CommentInfo{Text: "// 修改为使用哈希表"})
+	deName, deOK := filter.Match(models.CommentInfo{Text: "// Von Liste zu Set geändert"})
+
+	// then
+	assert.True(t, zhOK)
+	assert.Equal(t, "zh-changed-to", zhName)
+	assert.True(t, deOK)
+	assert.Equal(t, "de-changed", deName)
+}
+
+func Test_AgentMemoFilter_DetectScript_AppliesOnlyPacksOfCommentScript(t *testing.T) {
+	// given
+	all := make(map[string]struct{}, len(AgentMemoLanguages))
+	for _, lang := range AgentMemoLanguages {
+		all[lang] = struct{}{}
+	}
+	everyPack := NewAgentMemoFilterWithLanguages(all, false)
+	byScript := NewAgentMemoFilterWithLanguages(all, true)
+	koreanWithEnglishPrefix := models.CommentInfo{Text: "// Added 값의 합계"}
+	hanOnly := models.CommentInfo{Text: "// 追加"}
+	latin := models.CommentInfo{Text: "// Se cambió de lista a conjunto"}
+
+	// when
+	_, everyPackOK := everyPack.Match(koreanWithEnglishPrefix)
+	_, byScriptOK := byScript.Match(koreanWithEnglishPrefix)
+	hanName, hanOK := byScript.Match(hanOnly)
+	latinName, latinOK := byScript.Match(latin)
+
+	// then
+	assert.True(t, everyPackOK)
+	assert.False(t, byScriptOK, "Hangul comments only use the Korean pack")
+	assert.True(t, hanOK)
+	assert.Equal(t, "ja-added", hanName)
+	assert.True(t, latinOK)
+	assert.Equal(t, "es-changed", latinName)
+}
+
+func Test_DetectScript_ReturnsCommentScript(t *testing.T) {
+	tests := []struct {
+		text string
+		want *unicode.RangeTable
+	}{
+		{"값의 합계를 계산 (sum)", unicode.Hangul},
+		{"計算する", kana},
+		{"计算总和", unicode.Han},
+		{"Berechnet die Summe", unicode.Latin},
+		{"123 -> 456", nil},
+	}
+
+	for _, tt := range tests {
+		// when
+		script := detectScript(tt.text)
+
+		// then
+		assert.Equal(t, tt.want, script, tt.text)
+	}
+}
@@ -294,3 +294,49 @@ func Test_AgentMemoFilter_IsAgentMemo_NotAgentMemo_RegularKorean(t *testing.T) {
 	// then
 	assert.False(t, result)
 }
+
+func Test_AgentMemoFilter_Match_ReturnsPatternName(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilter()
+	comment := models.CommentInfo{Text: "# Refactored to use a map"}
+
+	// when
+	name, ok := filter.Match(comment)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, "refactored", name)
+}
+
+func Test_AgentMemoFilter_Annotate_RecordsVerdictAndPattern(t *testing.T) {
+	// given
+	filter := NewAgentMemoFilter()
+	memo := models.CommentInfo{Text: "// 여기서 값이 변경됨"}
+	regular := models.CommentInfo{Text: "// Calculate the sum"}
+
+	// when
+	annotatedMemo := filter.Annotate(memo)
+	annotatedRegular := filter.Annotate(regular)
+
+	// then
+	assert.Equal(t, "true", annotatedMemo.Metadata[models.MetadataAgentMemo])
+	assert.Equal(t, "ko-here", annotatedMemo.Metadata[models.MetadataAgentMemoPattern])
+	assert.Equal(t, "false", annotatedRegular.Metadata[models.MetadataAgentMemo])
+	assert.NotContains(t, annotatedRegular.Metadata, models.MetadataAgentMemoPattern)
+	assert.Nil(t, memo.Metadata)
+}
+
+func Test_AgentMemoPattern_PrefersRecordedVerdict(t *testing.T) {
+	// given
+	recordedNegative := models.CommentInfo{Text: "// Added retry", Metadata: map[string]string{models.MetadataAgentMemo: "false"}}
+	unannotated := models.CommentInfo{Text: "// Added retry"}
+
+	// when
+	_, recordedOK := AgentMemoPattern(recordedNegative)
+	name, unannotatedOK := AgentMemoPattern(unannotated)
+
+	// then
+	assert.False(t, recordedOK)
+	assert.True(t, unannotatedOK)
+	assert.Equal(t, "added", name)
+}
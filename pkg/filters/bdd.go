@@ -19,11 +19,23 @@ var BDDKeywords = map[string]struct{}{
 }
 
 // BDDFilter filters BDD-style comments.
-type BDDFilter struct{}
+type BDDFilter struct {
+	keywords map[string]struct{}
+}
 
-// NewBDDFilter creates a new BDDFilter.
+// NewBDDFilter creates a new BDDFilter using the default BDDKeywords.
 func NewBDDFilter() *BDDFilter {
-	return &BDDFilter{}
+	return NewBDDFilterWithKeywords(BDDKeywords)
+}
+
+// NewBDDFilterWithKeywords creates a new BDDFilter with a custom set of lowercase keywords.
+func NewBDDFilterWithKeywords(keywords map[string]struct{}) *BDDFilter {
+	return &BDDFilter{keywords: keywords}
+}
+
+// Name returns the filter name.
+func (f *BDDFilter) Name() string {
+	return "bdd"
 }
 
 // ShouldSkip returns true if the comment is a BDD keyword.
@@ -38,6 +50,6 @@ func (f *BDDFilter) ShouldSkip(comment models.CommentInfo) bool {
 		}
 	}
 
-	_, exists := BDDKeywords[normalized]
+	_, exists := f.keywords[normalized]
 	return exists
 }
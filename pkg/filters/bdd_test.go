@@ -91,3 +91,18 @@ func Test_ShouldSkip_WhenAmpersandThen_ReturnsTrue(t *testing.T) {
 	// then
 	assert.True(t, result)
 }
+
+func Test_ShouldSkip_CustomKeywords_UsesGivenSet(t *testing.T) {
+	// given
+	filter := NewBDDFilterWithKeywords(map[string]struct{}{"scenario": {}})
+	scenario := models.CommentInfo{Text: "# Scenario", CommentType: models.CommentTypeLine}
+	given := models.CommentInfo{Text: "# given", CommentType: models.CommentTypeLine}
+
+	// when
+	scenarioResult := filter.ShouldSkip(scenario)
+	givenResult := filter.ShouldSkip(given)
+
+	// then
+	assert.True(t, scenarioResult)
+	assert.False(t, givenResult)
+}
@@ -0,0 +1,45 @@
+package filters
+
+import (
+	"regexp"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// licensePattern matches copyright notices and license headers.
+var licensePattern = regexp.MustCompile(`(?i)\bcopyright\b|\(c\)\s*\d{4}|spdx-license-identifier:|\blicensed under\b|\ball rights reserved\b`)
+
+// IsLicense returns true if the comment is a copyright notice or license header.
+func IsLicense(comment models.CommentInfo) bool {
+	return licensePattern.MatchString(comment.Text)
+}
+
+// Categorize returns the category of a comment. When a comment fits several
+// categories the most specific wins: license, agent memo, commented-out code,
+// TODO, docstring, then plain comment.
+func Categorize(comment models.CommentInfo) models.Category {
+	switch {
+	case IsLicense(comment):
+		return models.CategoryLicense
+	case isAgentMemo(comment):
+		return models.CategoryAgentMemo
+	case comment.IsCommentedOutCode():
+		return models.CategoryCommentedOutCode
+	case isTodo(comment):
+		return models.CategoryTodo
+	case comment.IsDocstring:
+		return models.CategoryDocstring
+	default:
+		return models.CategoryComment
+	}
+}
+
+func isTodo(comment models.CommentInfo) bool {
+	_, ok := ParseTodo(comment.Text)
+	return ok
+}
+
+func isAgentMemo(comment models.CommentInfo) bool {
+	_, ok := AgentMemoPattern(comment)
+	return ok
+}
@@ -0,0 +1,36 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_Categorize_ReturnsMostSpecificCategory(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment models.CommentInfo
+		want    models.Category
+	}{
+		{"license", models.CommentInfo{Text: "// Copyright 2024 Acme Inc. All rights reserved."}, models.CategoryLicense},
+		{"spdx", models.CommentInfo{Text: "// SPDX-License-Identifier: MIT"}, models.CategoryLicense},
+		{"agent memo", models.CommentInfo{Text: "// Changed from map to slice"}, models.CategoryAgentMemo},
+		{"commented-out code", models.CommentInfo{Text: "// x := 1", Metadata: map[string]string{models.MetadataCommentedOutCode: "true"}}, models.CategoryCommentedOutCode},
+		{"todo", models.CommentInfo{Text: "// TODO: fix later"}, models.CategoryTodo},
+		{"docstring", models.CommentInfo{Text: `"""Greets."""`, IsDocstring: true}, models.CategoryDocstring},
+		{"license docstring", models.CommentInfo{Text: `"""Licensed under the Apache License."""`, IsDocstring: true}, models.CategoryLicense},
+		{"comment", models.CommentInfo{Text: "// compute the total"}, models.CategoryComment},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			category := Categorize(tt.comment)
+
+			// then
+			assert.Equal(t, tt.want, category)
+		})
+	}
+}
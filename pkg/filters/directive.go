@@ -30,11 +30,23 @@ var TypeCheckerPrefixes = []string{
 }
 
 // DirectiveFilter filters type checker and linter directives.
-type DirectiveFilter struct{}
+type DirectiveFilter struct {
+	prefixes []string
+}
 
-// NewDirectiveFilter creates a new DirectiveFilter.
+// NewDirectiveFilter creates a new DirectiveFilter using the default TypeCheckerPrefixes.
 func NewDirectiveFilter() *DirectiveFilter {
-	return &DirectiveFilter{}
+	return NewDirectiveFilterWithPrefixes(TypeCheckerPrefixes)
+}
+
+// NewDirectiveFilterWithPrefixes creates a new DirectiveFilter with custom lowercase prefixes.
+func NewDirectiveFilterWithPrefixes(prefixes []string) *DirectiveFilter {
+	return &DirectiveFilter{prefixes: prefixes}
+}
+
+// Name returns the filter name.
+func (f *DirectiveFilter) Name() string {
+	return "directive"
 }
 
 // ShouldSkip returns true if the comment is a directive.
@@ -55,7 +67,7 @@ func (f *DirectiveFilter) ShouldSkip(comment models.CommentInfo) bool {
 	}
 
 	// Check if starts with any directive prefix
-	for _, directive := range TypeCheckerPrefixes {
+	for _, directive := range f.prefixes {
 		if strings.HasPrefix(normalized, directive) {
 			return true
 		}
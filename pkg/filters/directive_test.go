@@ -108,3 +108,18 @@ func TestDirectiveFilter_ShouldSkip_RegularComment_ReturnsFalse(t *testing.T) {
 	// then
 	assert.False(t, result)
 }
+
+func TestDirectiveFilter_ShouldSkip_CustomPrefixes_UsesGivenList(t *testing.T) {
+	// given
+	filter := NewDirectiveFilterWithPrefixes([]string{"nolint"})
+	nolint := models.CommentInfo{Text: "//nolint:errcheck", CommentType: models.CommentTypeLine}
+	noqa := models.CommentInfo{Text: "# noqa: F401", CommentType: models.CommentTypeLine}
+
+	// when
+	nolintResult := filter.ShouldSkip(nolint)
+	noqaResult := filter.ShouldSkip(noqa)
+
+	// then
+	assert.True(t, nolintResult)
+	assert.False(t, noqaResult)
+}
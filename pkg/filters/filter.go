@@ -0,0 +1,42 @@
+package filters
+
+import (
+	"sync"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// Filter decides whether a detected comment is allowed and should be skipped.
+type Filter interface {
+	// Name returns the unique name used to enable or disable the filter.
+	Name() string
+	// ShouldSkip returns true if the comment is allowed.
+	ShouldSkip(comment models.CommentInfo) bool
+}
+
+// LanguageScoped is implemented by filters that only apply to some languages.
+// Languages are tree-sitter language names as reported in the comment's
+// "language" metadata (e.g. "python", "golang").
+type LanguageScoped interface {
+	Languages() []string
+}
+
+var (
+	globalMu      sync.RWMutex
+	globalFilters []Filter
+)
+
+// Register adds a filter to every registry created by NewDefaultRegistry.
+// It is intended to be called from init functions of packages providing custom filters.
+func Register(f Filter) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	globalFilters = append(globalFilters, f)
+}
+
+// registered returns a snapshot of globally registered filters.
+func registered() []Filter {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	return append([]Filter(nil), globalFilters...)
+}
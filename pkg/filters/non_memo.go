@@ -0,0 +1,28 @@
+package filters
+
+import (
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// NonMemoFilterName is the name of the NonMemoFilter.
+const NonMemoFilterName = "non-memo"
+
+// NonMemoFilter filters every comment that is not an agent memo, so that only
+// memo-style comments are reported.
+type NonMemoFilter struct{}
+
+// NewNonMemoFilter creates a new NonMemoFilter.
+func NewNonMemoFilter() *NonMemoFilter {
+	return &NonMemoFilter{}
+}
+
+// Name returns the filter name.
+func (f *NonMemoFilter) Name() string {
+	return NonMemoFilterName
+}
+
+// ShouldSkip returns true if the comment's agent memo verdict is negative.
+func (f *NonMemoFilter) ShouldSkip(comment models.CommentInfo) bool {
+	_, isMemo := AgentMemoPattern(comment)
+	return !isMemo
+}
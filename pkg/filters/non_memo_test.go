@@ -0,0 +1,20 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_NonMemoFilter_ShouldSkip_OnlyKeepsMemos(t *testing.T) {
+	// given
+	filter := NewNonMemoFilter()
+	memo := NewAgentMemoFilter().Annotate(models.CommentInfo{Text: "// Changed from slice to map"})
+	regular := NewAgentMemoFilter().Annotate(models.CommentInfo{Text: "// Calculate the sum"})
+
+	// when & then
+	assert.False(t, filter.ShouldSkip(memo))
+	assert.True(t, filter.ShouldSkip(regular))
+}
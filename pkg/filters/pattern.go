@@ -0,0 +1,37 @@
+package filters
+
+import (
+	"regexp"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// PatternFilter filters comments matching user-defined regular expressions.
+type PatternFilter struct {
+	patterns []*regexp.Regexp
+}
+
+// NewPatternFilter creates a new PatternFilter.
+func NewPatternFilter(patterns []*regexp.Regexp) *PatternFilter {
+	return &PatternFilter{patterns: patterns}
+}
+
+// Name returns the filter name.
+func (f *PatternFilter) Name() string {
+	return "allow-pattern"
+}
+
+// ShouldSkip returns true if the comment text matches any pattern.
+func (f *PatternFilter) ShouldSkip(comment models.CommentInfo) bool {
+	return MatchesAny(f.patterns, comment.Text)
+}
+
+// MatchesAny returns true if text matches at least one of the patterns.
+func MatchesAny(patterns []*regexp.Regexp, text string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
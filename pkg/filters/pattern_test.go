@@ -0,0 +1,34 @@
+package filters
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_PatternFilter_ShouldSkip_MatchingPattern_ReturnsTrue(t *testing.T) {
+	// given
+	filter := NewPatternFilter([]*regexp.Regexp{regexp.MustCompile(`^// SAFETY:`)})
+	comment := models.CommentInfo{Text: "// SAFETY: pointer is valid for the lifetime of buf"}
+
+	// when
+	result := filter.ShouldSkip(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_PatternFilter_ShouldSkip_NoPatterns_ReturnsFalse(t *testing.T) {
+	// given
+	filter := NewPatternFilter(nil)
+	comment := models.CommentInfo{Text: "// SAFETY: pointer is valid"}
+
+	// when
+	result := filter.ShouldSkip(comment)
+
+	// then
+	assert.False(t, result)
+}
@@ -0,0 +1,28 @@
+package filters
+
+import (
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// PragmaFilterName is the name of the PragmaFilter.
+const PragmaFilterName = "pragma"
+
+// PragmaFilter filters comments suppressed by comment-checker pragmas
+// ("comment-checker: allow", "allow-next-line", "disable" and "enable"),
+// including the pragma comments themselves.
+type PragmaFilter struct{}
+
+// NewPragmaFilter creates a new PragmaFilter.
+func NewPragmaFilter() *PragmaFilter {
+	return &PragmaFilter{}
+}
+
+// Name returns the filter name.
+func (f *PragmaFilter) Name() string {
+	return PragmaFilterName
+}
+
+// ShouldSkip returns true if the detector marked the comment as suppressed by a pragma.
+func (f *PragmaFilter) ShouldSkip(comment models.CommentInfo) bool {
+	return comment.Metadata[models.MetadataPragma] != ""
+}
@@ -0,0 +1,20 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_PragmaFilter_ShouldSkip_SuppressedComment_ReturnsTrue(t *testing.T) {
+	// given
+	filter := NewPragmaFilter()
+	suppressed := models.CommentInfo{Text: "// rationale", Metadata: map[string]string{models.MetadataPragma: "allow"}}
+	plain := models.CommentInfo{Text: "// comment-checker allow"}
+
+	// when & then
+	assert.True(t, filter.ShouldSkip(suppressed))
+	assert.False(t, filter.ShouldSkip(plain))
+}
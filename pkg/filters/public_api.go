@@ -0,0 +1,29 @@
+package filters
+
+import (
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// PublicAPIDocFilterName is the name of the PublicAPIDocFilter.
+const PublicAPIDocFilterName = "public-api-doc"
+
+// PublicAPIDocFilter filters doc comments attached to public API declarations:
+// exported Go identifiers, `pub` Rust items, exported TypeScript/JavaScript
+// symbols, public Java members and public Python functions, classes and modules.
+type PublicAPIDocFilter struct{}
+
+// NewPublicAPIDocFilter creates a new PublicAPIDocFilter.
+func NewPublicAPIDocFilter() *PublicAPIDocFilter {
+	return &PublicAPIDocFilter{}
+}
+
+// Name returns the filter name.
+func (f *PublicAPIDocFilter) Name() string {
+	return PublicAPIDocFilterName
+}
+
+// ShouldSkip returns true if the comment is a doc comment the detector marked as
+// documenting public API. Plain comments above a public declaration are not skipped.
+func (f *PublicAPIDocFilter) ShouldSkip(comment models.CommentInfo) bool {
+	return comment.IsDocstring && comment.Metadata[models.MetadataPublicAPI] == "true"
+}
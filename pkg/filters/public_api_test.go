@@ -0,0 +1,55 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_PublicAPIDocFilter_ShouldSkip_PublicDoc_ReturnsTrue(t *testing.T) {
+	// given
+	filter := NewPublicAPIDocFilter()
+	comment := models.CommentInfo{
+		Text:        "// Parse reads a config.",
+		IsDocstring: true,
+		Metadata:    map[string]string{models.MetadataPublicAPI: "true", models.MetadataSymbol: "Parse"},
+	}
+
+	// when
+	result := filter.ShouldSkip(comment)
+
+	// then
+	assert.True(t, result)
+}
+
+func Test_PublicAPIDocFilter_ShouldSkip_PrivateOrUnattached_ReturnsFalse(t *testing.T) {
+	// given
+	filter := NewPublicAPIDocFilter()
+	private := models.CommentInfo{
+		Text:        "// parse reads a config.",
+		IsDocstring: true,
+		Metadata:    map[string]string{models.MetadataPublicAPI: "false", models.MetadataSymbol: "parse"},
+	}
+	inline := models.CommentInfo{Text: "// increment"}
+
+	// when & then
+	assert.False(t, filter.ShouldSkip(private))
+	assert.False(t, filter.ShouldSkip(inline))
+}
+
+func Test_PublicAPIDocFilter_ShouldSkip_NonDocCommentOnPublicDecl_ReturnsFalse(t *testing.T) {
+	// given
+	filter := NewPublicAPIDocFilter()
+	comment := models.CommentInfo{
+		Text:     "// changed this to return early",
+		Metadata: map[string]string{models.MetadataPublicAPI: "true", models.MetadataSymbol: "run"},
+	}
+
+	// when
+	result := filter.ShouldSkip(comment)
+
+	// then
+	assert.False(t, result)
+}
@@ -0,0 +1,109 @@
+package filters
+
+import (
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// Registry holds an ordered set of filters that can be enabled or disabled by name.
+type Registry struct {
+	filters  []Filter
+	disabled map[string]struct{}
+}
+
+// NewRegistry creates a new Registry with the given filters in order.
+func NewRegistry(filters ...Filter) *Registry {
+	r := &Registry{disabled: make(map[string]struct{})}
+	for _, f := range filters {
+		r.Register(f)
+	}
+	return r
+}
+
+// NewDefaultRegistry creates a Registry with the built-in pragma, BDD, directive
+// and shebang filters followed by all globally registered filters.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry(NewPragmaFilter(), NewBDDFilter(), NewDirectiveFilter(), NewShebangFilter())
+	for _, f := range registered() {
+		r.Register(f)
+	}
+	return r
+}
+
+// Register adds a filter to the end of the chain.
+// A filter with the same name as an existing one replaces it in place.
+func (r *Registry) Register(f Filter) {
+	for i, existing := range r.filters {
+		if existing.Name() == f.Name() {
+			r.filters[i] = f
+			return
+		}
+	}
+	r.filters = append(r.filters, f)
+}
+
+// Enable re-enables a previously disabled filter.
+func (r *Registry) Enable(name string) {
+	delete(r.disabled, name)
+}
+
+// Disable turns off the filter with the given name.
+func (r *Registry) Disable(name string) {
+	r.disabled[name] = struct{}{}
+}
+
+// IsEnabled returns true if the filter with the given name is not disabled.
+func (r *Registry) IsEnabled(name string) bool {
+	_, disabled := r.disabled[name]
+	return !disabled
+}
+
+// Filters returns the enabled filters in order.
+func (r *Registry) Filters() []Filter {
+	var enabled []Filter
+	for _, f := range r.filters {
+		if r.IsEnabled(f.Name()) {
+			enabled = append(enabled, f)
+		}
+	}
+	return enabled
+}
+
+// Match returns the name of the first enabled filter that allows the comment.
+func (r *Registry) Match(comment models.CommentInfo) (string, bool) {
+	for _, f := range r.Filters() {
+		if !appliesTo(f, comment) {
+			continue
+		}
+		if f.ShouldSkip(comment) {
+			return f.Name(), true
+		}
+	}
+	return "", false
+}
+
+// Apply returns the comments not allowed by any enabled filter.
+func (r *Registry) Apply(comments []models.CommentInfo) []models.CommentInfo {
+	var remaining []models.CommentInfo
+	for _, c := range comments {
+		if _, skipped := r.Match(c); skipped {
+			continue
+		}
+		remaining = append(remaining, c)
+	}
+	return remaining
+}
+
+// appliesTo returns true if the filter is in scope for the comment's language.
+func appliesTo(f Filter, comment models.CommentInfo) bool {
+	scoped, ok := f.(LanguageScoped)
+	if !ok {
+		return true
+	}
+	lang := comment.Metadata[models.MetadataLanguage]
+	for _, l := range scoped.Languages() {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package filters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+type prefixFilter struct {
+	name      string
+	prefix    string
+	languages []string
+}
+
+func (f *prefixFilter) Name() string { return f.name }
+
+func (f *prefixFilter) ShouldSkip(comment models.CommentInfo) bool {
+	return strings.HasPrefix(comment.Text, f.prefix)
+}
+
+type scopedPrefixFilter struct {
+	prefixFilter
+}
+
+func (f *scopedPrefixFilter) Languages() []string { return f.languages }
+
+func Test_Registry_Match_DefaultFilters_ReturnsFilterName(t *testing.T) {
+	// given
+	registry := NewDefaultRegistry()
+	comment := models.CommentInfo{Text: "# noqa: E501"}
+
+	// when
+	name, skipped := registry.Match(comment)
+
+	// then
+	assert.True(t, skipped)
+	assert.Equal(t, "directive", name)
+}
+
+func Test_Registry_Disable_SkipsDisabledFilter(t *testing.T) {
+	// given
+	registry := NewDefaultRegistry()
+	registry.Disable("bdd")
+	comment := models.CommentInfo{Text: "# given"}
+
+	// when
+	_, skipped := registry.Match(comment)
+
+	// then
+	assert.False(t, skipped)
+	assert.False(t, registry.IsEnabled("bdd"))
+}
+
+func Test_Registry_Enable_RestoresDisabledFilter(t *testing.T) {
+	// given
+	registry := NewDefaultRegistry()
+	registry.Disable("shebang")
+	registry.Enable("shebang")
+	comment := models.CommentInfo{Text: "#!/bin/sh"}
+
+	// when
+	name, skipped := registry.Match(comment)
+
+	// then
+	assert.True(t, skipped)
+	assert.Equal(t, "shebang", name)
+}
+
+func Test_Registry_Register_SameNameReplacesInPlace(t *testing.T) {
+	// given
+	registry := NewDefaultRegistry()
+	registry.Register(NewBDDFilterWithKeywords(map[string]struct{}{"scenario": {}}))
+
+	// when
+	names := make([]string, 0)
+	for _, f := range registry.Filters() {
+		names = append(names, f.Name())
+	}
+	_, givenSkipped := registry.Match(models.CommentInfo{Text: "# given"})
+	_, scenarioSkipped := registry.Match(models.CommentInfo{Text: "# scenario"})
+
+	// then
+	assert.Equal(t, []string{"pragma", "bdd", "directive", "shebang"}, names)
+	assert.False(t, givenSkipped)
+	assert.True(t, scenarioSkipped)
+}
+
+func Test_Registry_Match_LanguageScopedFilter_OnlyAppliesToListedLanguages(t *testing.T) {
+	// given
+	registry := NewRegistry(&scopedPrefixFilter{prefixFilter{name: "safety", prefix: "// SAFETY:", languages: []string{"rust"}}})
+	rustComment := models.CommentInfo{Text: "// SAFETY: checked", Metadata: map[string]string{models.MetadataLanguage: "rust"}}
+	goComment := models.CommentInfo{Text: "// SAFETY: checked", Metadata: map[string]string{models.MetadataLanguage: "golang"}}
+
+	// when
+	_, rustSkipped := registry.Match(rustComment)
+	_, goSkipped := registry.Match(goComment)
+
+	// then
+	assert.True(t, rustSkipped)
+	assert.False(t, goSkipped)
+}
+
+func Test_Registry_Apply_ReturnsUnfilteredComments(t *testing.T) {
+	// given
+	registry := NewRegistry(&prefixFilter{name: "org", prefix: "// ORG:"})
+	comments := []models.CommentInfo{
+		{Text: "// ORG: required legal notice"},
+		{Text: "// plain comment"},
+	}
+
+	// when
+	remaining := registry.Apply(comments)
+
+	// then
+	assert.Len(t, remaining, 1)
+	assert.Equal(t, "// plain comment", remaining[0].Text)
+}
+
+func Test_Register_GlobalFilter_IncludedInDefaultRegistry(t *testing.T) {
+	// given
+	globalMu.Lock()
+	saved := globalFilters
+	globalMu.Unlock()
+	t.Cleanup(func() {
+		globalMu.Lock()
+		globalFilters = saved
+		globalMu.Unlock()
+	})
+	Register(&prefixFilter{name: "license", prefix: "// Copyright"})
+
+	// when
+	name, skipped := NewDefaultRegistry().Match(models.CommentInfo{Text: "// Copyright 2024 Acme"})
+
+	// then
+	assert.True(t, skipped)
+	assert.Equal(t, "license", name)
+}
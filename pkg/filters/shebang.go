@@ -14,6 +14,11 @@ func NewShebangFilter() *ShebangFilter {
 	return &ShebangFilter{}
 }
 
+// Name returns the filter name.
+func (f *ShebangFilter) Name() string {
+	return "shebang"
+}
+
 // ShouldSkip returns true if the comment is a shebang.
 func (f *ShebangFilter) ShouldSkip(comment models.CommentInfo) bool {
 	stripped := strings.TrimSpace(comment.Text)
@@ -0,0 +1,100 @@
+package filters
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// TodoFilterName is the name of the TodoFilter.
+const TodoFilterName = "todo"
+
+// todoPattern matches a TODO, FIXME, HACK, XXX or BUG marker at the start of a
+// comment, with an optional parenthesized reference such as TODO(PROJ-123) or TODO(alice).
+var todoPattern = regexp.MustCompile(`^[\s#/*;!-]*((?i:todo|fixme)|HACK|XXX|BUG)\b(?:\(([^)]*)\))?`)
+
+// ticketPattern matches issue keys (PROJ-123), issue numbers (#123) and URLs.
+var ticketPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-\d+\b|#\d+\b|https?://\S+`)
+
+// ownerPattern matches an @mention naming who owns the work.
+var ownerPattern = regexp.MustCompile(`(?:^|\s)@[\w.-]+`)
+
+// handlePattern matches a parenthesized owner such as "alice" or "@bob";
+// free text like TODO(fix this later) names nobody.
+var handlePattern = regexp.MustCompile(`^@?[\w.-]+$`)
+
+// Todo is a work-item marker parsed from a comment.
+type Todo struct {
+	// Marker is the marker keyword in upper case, e.g. "TODO" or "FIXME".
+	Marker string
+	// Ticket is the referenced issue key, issue number or URL, if any.
+	Ticket string
+	// Owner is the person the work is assigned to, if any.
+	Owner string
+}
+
+// IsTracked returns true if the TODO references a ticket or an owner.
+func (t Todo) IsTracked() bool {
+	return t.Ticket != "" || t.Owner != ""
+}
+
+// ParseTodo recognizes comments starting with a TODO, FIXME, HACK, XXX or BUG
+// marker. References are read from the parentheses after the marker, e.g.
+// TODO(PROJ-123) or TODO(alice), or from anywhere in the comment.
+// Returns false if the comment is not a TODO.
+func ParseTodo(text string) (Todo, bool) {
+	text = strings.TrimSpace(text)
+	match := todoPattern.FindStringSubmatch(text)
+	if match == nil {
+		return Todo{}, false
+	}
+
+	todo := Todo{Marker: strings.ToUpper(match[1])}
+	if reference := strings.TrimSpace(match[2]); reference != "" {
+		switch {
+		case ticketPattern.MatchString(reference):
+			todo.Ticket = reference
+		case handlePattern.MatchString(reference):
+			todo.Owner = strings.TrimPrefix(reference, "@")
+		}
+	}
+
+	rest := text[len(match[0]):]
+	if todo.Ticket == "" {
+		todo.Ticket = ticketPattern.FindString(rest)
+	}
+	if todo.Owner == "" {
+		todo.Owner = strings.TrimPrefix(strings.TrimSpace(ownerPattern.FindString(rest)), "@")
+	}
+	return todo, true
+}
+
+// TodoFilter filters TODOs that are tracked: those referencing a ticket, and
+// unless a ticket is required, those naming an owner. Bare TODOs are kept.
+type TodoFilter struct {
+	requireTicket bool
+}
+
+// NewTodoFilter creates a new TodoFilter. If requireTicket is true, TODOs that
+// only name an owner are not allowed.
+func NewTodoFilter(requireTicket bool) *TodoFilter {
+	return &TodoFilter{requireTicket: requireTicket}
+}
+
+// Name returns the filter name.
+func (f *TodoFilter) Name() string {
+	return TodoFilterName
+}
+
+// ShouldSkip returns true if the comment is a tracked TODO.
+func (f *TodoFilter) ShouldSkip(comment models.CommentInfo) bool {
+	todo, ok := ParseTodo(comment.Text)
+	if !ok {
+		return false
+	}
+	if f.requireTicket {
+		return todo.Ticket != ""
+	}
+	return todo.IsTracked()
+}
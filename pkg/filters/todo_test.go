@@ -0,0 +1,67 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_ParseTodo_RecognizesMarkersAndReferences(t *testing.T) {
+	tests := []struct {
+		text string
+		want Todo
+	}{
+		{"// TODO: fix later", Todo{Marker: "TODO"}},
+		{"# todo refactor", Todo{Marker: "TODO"}},
+		{"// TODO(PROJ-123): drop the fallback", Todo{Marker: "TODO", Ticket: "PROJ-123"}},
+		{"/* FIXME(alice): handle EOF */", Todo{Marker: "FIXME", Owner: "alice"}},
+		{"// HACK(@bob) until the API is fixed", Todo{Marker: "HACK", Owner: "bob"}},
+		{"// XXX see https://github.com/o/r/issues/4", Todo{Marker: "XXX", Ticket: "https://github.com/o/r/issues/4"}},
+		{"-- BUG: wrong totals, tracked in #42", Todo{Marker: "BUG", Ticket: "#42"}},
+		{"// TODO @carol: remove after launch", Todo{Marker: "TODO", Owner: "carol"}},
+		{"// TODO(fix this later): drop the fallback", Todo{Marker: "TODO"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			// when
+			todo, ok := ParseTodo(tt.text)
+
+			// then
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, todo)
+		})
+	}
+}
+
+func Test_ParseTodo_OtherComments_ReturnsFalse(t *testing.T) {
+	for _, text := range []string{"// todos are tracked elsewhere", "// a TODO list", "// Bug fixes below", "// XXXL size"} {
+		// when
+		_, ok := ParseTodo(text)
+
+		// then
+		assert.False(t, ok, text)
+	}
+}
+
+func Test_TodoFilter_ShouldSkip_TrackedTodos(t *testing.T) {
+	// given
+	tracked := NewTodoFilter(false)
+	ticketOnly := NewTodoFilter(true)
+	bare := models.CommentInfo{Text: "// TODO: fix later"}
+	owned := models.CommentInfo{Text: "// TODO(alice): fix later"}
+	ticketed := models.CommentInfo{Text: "// TODO(PROJ-7): fix later"}
+	plain := models.CommentInfo{Text: "// fix later"}
+	freeText := models.CommentInfo{Text: "// TODO(fix this later): drop the fallback"}
+
+	// when & then
+	assert.False(t, tracked.ShouldSkip(bare))
+	assert.True(t, tracked.ShouldSkip(owned))
+	assert.True(t, tracked.ShouldSkip(ticketed))
+	assert.False(t, tracked.ShouldSkip(plain))
+	assert.False(t, tracked.ShouldSkip(freeText))
+	assert.False(t, ticketOnly.ShouldSkip(owned))
+	assert.True(t, ticketOnly.ShouldSkip(ticketed))
+}
@@ -0,0 +1,185 @@
+package fix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change.
+const contextLines = 3
+
+// opKind identifies a line in an edit script.
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+// lineOp is a single line of an edit script with its 0-based positions.
+type lineOp struct {
+	kind     opKind
+	text     string
+	oldIndex int
+	newIndex int
+}
+
+// UnifiedDiff returns a unified diff from before to after for the given path,
+// or an empty string if the contents are equal.
+func UnifiedDiff(path, before, after string) string {
+	if before == after {
+		return ""
+	}
+
+	oldLines := splitLines(before)
+	newLines := splitLines(after)
+	ops := diffLines(oldLines, newLines)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("--- a/%s\n+++ b/%s\n", path, path))
+	for _, hunk := range groupHunks(ops) {
+		writeHunk(&sb, hunk)
+	}
+	return sb.String()
+}
+
+// splitLines splits s into lines, keeping line terminators.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines computes a shortest edit script using Myers' algorithm.
+func diffLines(a, b []string) []lineOp {
+	n, m := len(a), len(b)
+	total := n + m
+	offset := total + 1
+	v := make([]int, 2*total+2)
+	var trace [][]int
+
+search:
+	for d := 0; d <= total; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// Walk the trace backwards to recover the edit script.
+	var ops []lineOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		vd := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && vd[offset+k-1] < vd[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := vd[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, lineOp{kind: opEqual, text: a[x], oldIndex: x, newIndex: y})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, lineOp{kind: opInsert, text: b[y], oldIndex: x, newIndex: y})
+		} else {
+			x--
+			ops = append(ops, lineOp{kind: opDelete, text: a[x], oldIndex: x, newIndex: y})
+		}
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// groupHunks splits an edit script into hunks of changes with surrounding context.
+func groupHunks(ops []lineOp) [][]lineOp {
+	var hunks [][]lineOp
+	start, end := -1, -1
+	for i, op := range ops {
+		if op.kind == opEqual {
+			continue
+		}
+		lo := max(i-contextLines, 0)
+		if start >= 0 && lo > end {
+			hunks = append(hunks, ops[start:end])
+			start = -1
+		}
+		if start < 0 {
+			start = lo
+		}
+		end = min(i+contextLines+1, len(ops))
+	}
+	if start >= 0 {
+		hunks = append(hunks, ops[start:end])
+	}
+	return hunks
+}
+
+// writeHunk writes a hunk header and its lines.
+func writeHunk(sb *strings.Builder, hunk []lineOp) {
+	oldStart, newStart := hunk[0].oldIndex+1, hunk[0].newIndex+1
+	oldCount, newCount := 0, 0
+	for _, op := range hunk {
+		if op.kind != opInsert {
+			oldCount++
+		}
+		if op.kind != opDelete {
+			newCount++
+		}
+	}
+	if oldCount == 0 {
+		oldStart--
+	}
+	if newCount == 0 {
+		newStart--
+	}
+
+	sb.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount))
+	for _, op := range hunk {
+		prefix := " "
+		switch op.kind {
+		case opDelete:
+			prefix = "-"
+		case opInsert:
+			prefix = "+"
+		}
+		sb.WriteString(prefix)
+		sb.WriteString(op.text)
+		if !strings.HasSuffix(op.text, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
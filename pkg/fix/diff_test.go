@@ -0,0 +1,70 @@
+package fix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UnifiedDiff_EqualContent_ReturnsEmptyString(t *testing.T) {
+	// given
+	content := "a\nb\n"
+
+	// when
+	result := UnifiedDiff("x.go", content, content)
+
+	// then
+	assert.Empty(t, result)
+}
+
+func Test_UnifiedDiff_RemovedLine_ShowsHunkWithContext(t *testing.T) {
+	// given
+	before := "1\n2\n3\n4\n5\n6\n7\n8\n9\n"
+	after := "1\n2\n3\n4\n6\n7\n8\n9\n"
+
+	// when
+	result := UnifiedDiff("x.go", before, after)
+
+	// then
+	expected := "--- a/x.go\n+++ b/x.go\n@@ -2,7 +2,6 @@\n 2\n 3\n 4\n-5\n 6\n 7\n 8\n"
+	assert.Equal(t, expected, result)
+}
+
+func Test_UnifiedDiff_DistantChanges_ProducesSeparateHunks(t *testing.T) {
+	// given
+	before := "a\n1\n2\n3\n4\n5\n6\n7\n8\nb\n"
+	after := "1\n2\n3\n4\n5\n6\n7\n8\n"
+
+	// when
+	result := UnifiedDiff("x.go", before, after)
+
+	// then
+	expected := "--- a/x.go\n+++ b/x.go\n" +
+		"@@ -1,4 +1,3 @@\n-a\n 1\n 2\n 3\n" +
+		"@@ -7,4 +6,3 @@\n 6\n 7\n 8\n-b\n"
+	assert.Equal(t, expected, result)
+}
+
+func Test_UnifiedDiff_ChangedLine_ShowsDeleteAndInsert(t *testing.T) {
+	// given
+	before := "x = 1  # note\n"
+	after := "x = 1\n"
+
+	// when
+	result := UnifiedDiff("a.py", before, after)
+
+	// then
+	assert.Equal(t, "--- a/a.py\n+++ b/a.py\n@@ -1,1 +1,1 @@\n-x = 1  # note\n+x = 1\n", result)
+}
+
+func Test_UnifiedDiff_MissingFinalNewline_IsMarked(t *testing.T) {
+	// given
+	before := "x\n# c"
+	after := "x"
+
+	// when
+	result := UnifiedDiff("a.py", before, after)
+
+	// then
+	assert.Contains(t, result, "-x\n-# c\n\\ No newline at end of file\n+x\n\\ No newline at end of file\n")
+}
@@ -0,0 +1,50 @@
+package fix
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// directivePatterns match comments that tools read as instructions: removing
+// them leaves code that still parses but builds or behaves differently.
+var directivePatterns = []*regexp.Regexp{
+	// Go compiler and toolchain directives: //go:build, //go:embed, //go:generate, //line, cgo //export
+	regexp.MustCompile(`^//(go:|line |export |extern |sys |nolint)`),
+	regexp.MustCompile(`^//\s*\+build\s`),
+	// Editor folding regions: // #region, //#endregion, # region
+	regexp.MustCompile(`^(//|#)\s*#?\s*(end)?region\b`),
+	regexp.MustCompile(`^//\s*swift-tools-version:`),
+	// Ruby magic comments
+	regexp.MustCompile(`^#\s*(frozen_string_literal|warn_indent|shareable_constant_value|encoding):`),
+	// Emacs file variables (-*- coding: utf-8 -*-) and vim modelines
+	regexp.MustCompile(`-\*-.*-\*-`),
+	regexp.MustCompile(`\b(vim?|ex):\s*(set?\s|\w+=)`),
+	// Bundler and JSX pragmas
+	regexp.MustCompile(`[#@]__(PURE|NO_SIDE_EFFECTS)__`),
+	regexp.MustCompile(`@(flow|jsx|jsxImportSource|jsxRuntime)\b`),
+}
+
+// codingCookie matches a Python encoding declaration (PEP 263), which only
+// counts on the first two lines of a file.
+var codingCookie = regexp.MustCompile(`^[ \t\f]*#.*?coding[:=][ \t]*[-\w.]+`)
+
+// IsDirective returns true if the comment is a compiler, build or editor
+// directive such as //go:build, //go:embed, // +build, //line, //export,
+// #region, a shebang or an encoding cookie. Such comments must not be removed.
+func IsDirective(comment models.CommentInfo) bool {
+	text := strings.TrimSpace(comment.Text)
+	if comment.LineNumber == 1 && strings.HasPrefix(text, "#!") {
+		return true
+	}
+	if comment.LineNumber <= 2 && codingCookie.MatchString(text) {
+		return true
+	}
+	for _, pattern := range directivePatterns {
+		if pattern.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package fix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_IsDirective_GoDirectives_ReturnsTrue(t *testing.T) {
+	// given
+	content := "//go:build linux\n// +build linux\n\n// Package g does things.\npackage g\n\nimport _ \"embed\"\n\n//go:generate stringer -type=Kind\n\n//go:embed data.txt\nvar data string\n\n//line g.go:1\n// set x\nvar x = 1\n"
+	comments := core.NewCommentDetector().Detect(content, "g.go", true)
+
+	// when
+	var kept []string
+	for _, c := range comments {
+		if !IsDirective(c) {
+			kept = append(kept, c.Text)
+		}
+	}
+
+	// then
+	assert.Equal(t, []string{"// Package g does things.", "// set x"}, kept)
+}
+
+func Test_IsDirective_OtherDirectives(t *testing.T) {
+	tests := []struct {
+		text string
+		line int
+		want bool
+	}{
+		{"#!/usr/bin/env python3", 1, true},
+		{"# -*- coding: utf-8 -*-", 2, true},
+		{"# coding=latin-1", 1, true},
+		{"# coding: utf-8", 5, false},
+		{"# frozen_string_literal: true", 1, true},
+		{"// #region helpers", 10, true},
+		{"//#endregion", 10, true},
+		{"//export Add", 10, true},
+		{"/* @jsx h */", 1, true},
+		{"/*#__PURE__*/", 3, true},
+		{"# vim: set ts=4 sw=4:", 40, true},
+		{"// the region code", 3, false},
+		{"// go build this later", 3, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			// when
+			got := IsDirective(models.CommentInfo{Text: tt.text, LineNumber: tt.line})
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Package fix removes flagged comments from source code.
+package fix
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// Result holds the outcome of removing comments from one file.
+type Result struct {
+	// Content is the source code with the removable comments deleted.
+	Content string
+	// Removed lists the comments that were deleted.
+	Removed []models.CommentInfo
+	// Skipped lists the comments that were kept because deleting them
+	// would have broken the code or their range was unknown.
+	Skipped []models.CommentInfo
+}
+
+// Changed returns true if at least one comment was removed.
+func (r Result) Changed() bool {
+	return len(r.Removed) > 0
+}
+
+// Fixer deletes comments by their byte ranges and verifies the result still parses.
+type Fixer struct {
+	detector *core.CommentDetector
+}
+
+// NewFixer creates a new Fixer using the given detector to verify parses.
+func NewFixer(detector *core.CommentDetector) *Fixer {
+	return &Fixer{detector: detector}
+}
+
+// Apply removes the given comments from content.
+// Whole-line comments are deleted along with their line, trailing comments are
+// deleted along with the whitespace before them, and indentation is preserved.
+// A removal that introduces syntax errors is reverted and reported as skipped.
+func (f *Fixer) Apply(content, filePath string, comments []models.CommentInfo) Result {
+	candidates, skipped := removable(content, comments)
+
+	baseline := f.detector.ErrorCount(content, filePath)
+	if fixed := removeAll(content, candidates); f.parsesAsWell(fixed, filePath, baseline) {
+		return Result{Content: fixed, Removed: candidates, Skipped: skipped}
+	}
+
+	// Some removal breaks the code; find it by removing comments one at a time,
+	// last to first so earlier byte offsets stay valid.
+	result := Result{Content: content}
+	for i := len(candidates) - 1; i >= 0; i-- {
+		fixed := removeAll(result.Content, candidates[i:i+1])
+		if f.parsesAsWell(fixed, filePath, baseline) {
+			result.Content = fixed
+			result.Removed = append(result.Removed, candidates[i])
+		} else {
+			skipped = append(skipped, candidates[i])
+		}
+	}
+	reverse(result.Removed)
+	result.Skipped = skipped
+	return result
+}
+
+// parsesAsWell returns true if content has no more syntax errors than the baseline.
+func (f *Fixer) parsesAsWell(content, filePath string, baseline int) bool {
+	return f.detector.ErrorCount(content, filePath) <= baseline
+}
+
+// removable sorts comments by position and separates those with a usable byte
+// range from those that cannot be removed safely.
+func removable(content string, comments []models.CommentInfo) (candidates, skipped []models.CommentInfo) {
+	sorted := make([]models.CommentInfo, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartByte < sorted[j].StartByte
+	})
+
+	end := 0
+	for _, c := range sorted {
+		valid := c.EndByte > c.StartByte && c.EndByte <= len(content) &&
+			content[c.StartByte:c.EndByte] == c.Text
+		if !valid || c.StartByte < end {
+			skipped = append(skipped, c)
+			continue
+		}
+		candidates = append(candidates, c)
+		end = c.EndByte
+	}
+	return candidates, skipped
+}
+
+// removeAll deletes the comments, which must be sorted and non-overlapping.
+func removeAll(content string, comments []models.CommentInfo) string {
+	for i := len(comments) - 1; i >= 0; i-- {
+		start, end, replacement := removalRange(content, comments[i])
+		content = content[:start] + replacement + content[end:]
+	}
+	return content
+}
+
+// removalRange widens a comment's byte range to cover the surrounding
+// whitespace that should disappear with it, and returns the text to put in its place.
+func removalRange(content string, c models.CommentInfo) (int, int, string) {
+	lineStart := strings.LastIndexByte(content[:c.StartByte], '\n') + 1
+	lineEnd := len(content)
+	if i := strings.IndexByte(content[c.EndByte:], '\n'); i >= 0 {
+		lineEnd = c.EndByte + i
+	}
+
+	before := content[lineStart:c.StartByte]
+	after := content[c.EndByte:lineEnd]
+
+	switch {
+	case isBlank(before) && isBlank(after):
+		if isOnlyStatement(content, c, lineStart, lineEnd) {
+			// A Python string that is the whole body must leave a statement behind.
+			return c.StartByte, c.EndByte, "pass"
+		}
+		// The comment occupies whole lines: drop them including the line break.
+		// At the top of the file, a blank line after the comment goes too.
+		if lineEnd < len(content) {
+			end := lineEnd + 1
+			if next := strings.IndexByte(content[end:], '\n'); lineStart == 0 && next >= 0 && isBlank(content[end:end+next]) {
+				end += next + 1
+			}
+			return lineStart, end, ""
+		}
+		if lineStart > 0 {
+			return lineStart - 1, lineEnd, ""
+		}
+		return lineStart, lineEnd, ""
+	case isBlank(after):
+		// Trailing comment: drop it with the whitespace separating it from the code.
+		return lineStart + len(strings.TrimRight(before, " \t")), c.EndByte + len(strings.TrimRight(after, "\r")), ""
+	default:
+		// Comment embedded in code: drop it with the whitespace after it,
+		// keeping a single space if the code on both sides would otherwise merge.
+		end := c.EndByte + len(after) - len(strings.TrimLeft(after, " \t"))
+		if isWordByte(lastByte(before)) && isWordByte(firstByte(content[end:lineEnd])) {
+			return c.StartByte, end, " "
+		}
+		return c.StartByte, end, ""
+	}
+}
+
+// isOnlyStatement returns true if c is a Python docstring or string comment that
+// forms the entire body of its block, so removing it would leave the block empty.
+func isOnlyStatement(content string, c models.CommentInfo, lineStart, lineEnd int) bool {
+	isString := c.CommentType == models.CommentTypeDocstring || c.CommentType == models.CommentTypeStringComment
+	if !isString || c.Metadata[models.MetadataLanguage] != "python" {
+		return false
+	}
+
+	previous := strings.TrimRight(content[:lineStart], " \t\r\n")
+	if !strings.HasSuffix(previous, ":") {
+		return false
+	}
+
+	indent := c.StartByte - lineStart
+	for _, line := range strings.Split(content[lineEnd:], "\n") {
+		if isBlank(line) {
+			continue
+		}
+		return len(line)-len(strings.TrimLeft(line, " \t")) < indent
+	}
+	return true
+}
+
+// isBlank returns true if s contains only spaces, tabs and carriage returns.
+func isBlank(s string) bool {
+	return strings.Trim(s, " \t\r") == ""
+}
+
+// isWordByte returns true if b can be part of an identifier, number or keyword.
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 0x80 ||
+		('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+func lastByte(s string) byte {
+	if s == "" {
+		return 0
+	}
+	return s[len(s)-1]
+}
+
+func firstByte(s string) byte {
+	if s == "" {
+		return 0
+	}
+	return s[0]
+}
+
+// reverse reverses comments in place.
+func reverse(comments []models.CommentInfo) {
+	for i, j := 0, len(comments)-1; i < j; i, j = i+1, j-1 {
+		comments[i], comments[j] = comments[j], comments[i]
+	}
+}
@@ -0,0 +1,162 @@
+package fix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func applyAll(t *testing.T, content, filePath string) Result {
+	t.Helper()
+	detector := core.NewCommentDetector()
+	comments := detector.Detect(content, filePath, true)
+	return NewFixer(detector).Apply(content, filePath, comments)
+}
+
+func Test_Apply_WholeLineComment_RemovesLineKeepingIndentation(t *testing.T) {
+	// given
+	content := "func main() {\n\t// set x\n\tx := 1\n\t_ = x\n}\n"
+
+	// when
+	result := applyAll(t, content, "main.go")
+
+	// then
+	assert.Equal(t, "func main() {\n\tx := 1\n\t_ = x\n}\n", result.Content)
+	assert.Len(t, result.Removed, 1)
+	assert.Empty(t, result.Skipped)
+}
+
+func Test_Apply_TrailingComment_RemovesCommentAndSeparatingSpace(t *testing.T) {
+	// given
+	content := "x = 1  # set x\ny = 2\n"
+
+	// when
+	result := applyAll(t, content, "main.py")
+
+	// then
+	assert.Equal(t, "x = 1\ny = 2\n", result.Content)
+}
+
+func Test_Apply_MultiLineBlockComment_RemovesAllLines(t *testing.T) {
+	// given
+	content := "int a;\n/*\n * explain\n */\nint b;\n"
+
+	// when
+	result := applyAll(t, content, "main.c")
+
+	// then
+	assert.Equal(t, "int a;\nint b;\n", result.Content)
+}
+
+func Test_Apply_InlineBlockComment_KeepsSurroundingCode(t *testing.T) {
+	// given
+	content := "int a = f(/* first */ 1, 2);\n"
+
+	// when
+	result := applyAll(t, content, "main.c")
+
+	// then
+	assert.Equal(t, "int a = f(1, 2);\n", result.Content)
+}
+
+func Test_Apply_CRLFTrailingComment_KeepsLineEnding(t *testing.T) {
+	// given
+	content := "x = 1 # note\r\ny = 2\r\n"
+
+	// when
+	result := applyAll(t, content, "main.py")
+
+	// then
+	assert.Equal(t, "x = 1\r\ny = 2\r\n", result.Content)
+}
+
+func Test_Apply_LastLineWithoutNewline_RemovesPrecedingLineBreak(t *testing.T) {
+	// given
+	content := "x = 1\n# done"
+
+	// when
+	result := applyAll(t, content, "main.py")
+
+	// then
+	assert.Equal(t, "x = 1", result.Content)
+}
+
+func Test_Apply_CommentSeparatingTokens_KeepsThemApart(t *testing.T) {
+	// given
+	content := "int/**/x;\n"
+
+	// when
+	result := applyAll(t, content, "main.c")
+
+	// then
+	assert.Equal(t, "int x;\n", result.Content)
+}
+
+func Test_Apply_DocstringAsOnlyBody_LeavesPass(t *testing.T) {
+	// given
+	content := "def f():\n    \"\"\"Only a docstring.\"\"\"\n\ndef g():\n    \"\"\"Doc.\"\"\"\n    return 1\n"
+
+	// when
+	result := applyAll(t, content, "main.py")
+
+	// then
+	assert.Equal(t, "def f():\n    pass\n\ndef g():\n    return 1\n", result.Content)
+	assert.Len(t, result.Removed, 2)
+}
+
+func Test_Apply_RemovalBreakingSyntax_IsSkipped(t *testing.T) {
+	// given
+	detector := core.NewCommentDetector()
+	content := "int f(void) { return 0; }\n// note\n"
+	comments := detector.Detect(content, "main.c", false)
+	brace := models.CommentInfo{Text: "{", StartByte: 12, EndByte: 13}
+
+	// when
+	result := NewFixer(detector).Apply(content, "main.c", append(comments, brace))
+
+	// then
+	assert.Equal(t, "int f(void) { return 0; }\n", result.Content)
+	assert.Len(t, result.Removed, 1)
+	assert.Equal(t, []models.CommentInfo{brace}, result.Skipped)
+}
+
+func Test_Apply_StaleRange_IsSkipped(t *testing.T) {
+	// given
+	detector := core.NewCommentDetector()
+	comments := detector.Detect("// old\nx := 1\n", "main.go", false)
+	content := "// new\nx := 1\n"
+
+	// when
+	result := NewFixer(detector).Apply(content, "main.go", comments)
+
+	// then
+	assert.Equal(t, content, result.Content)
+	assert.False(t, result.Changed())
+	assert.Len(t, result.Skipped, 1)
+}
+
+func Test_Apply_StringComment_RemovesStatement(t *testing.T) {
+	// given
+	content := "def f(x):\n    \"\"\"Doc.\"\"\"\n    \"\"\"Double it.\"\"\"\n    x *= 2\n    if x:\n        \"note\"\n    return x\n"
+
+	// when
+	result := applyAll(t, content, "main.py")
+
+	// then
+	assert.Equal(t, "def f(x):\n    x *= 2\n    if x:\n        pass\n    return x\n", result.Content)
+	assert.Len(t, result.Removed, 3)
+}
+
+func Test_Apply_FirstLineComment_DropsFollowingBlankLine(t *testing.T) {
+	// given
+	content := "# set up\n\nx = 1\n"
+
+	// when
+	result := applyAll(t, content, "main.py")
+
+	// then
+	assert.Equal(t, "x = 1\n", result.Content)
+}
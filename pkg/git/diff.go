@@ -0,0 +1,138 @@
+// Package git provides helpers for reading diffs and file revisions from a local repository.
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// FileDiff holds the lines added to a single file by a diff.
+type FileDiff struct {
+	Path       string
+	AddedLines map[int]struct{}
+}
+
+// Run executes git with the given arguments in dir and returns its stdout.
+// An empty dir runs git in the current working directory.
+func Run(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-c", "core.quotepath=off"}, args...)...)
+	cmd.Dir = dir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), msg)
+	}
+	return stdout.String(), nil
+}
+
+// TopLevel returns the root directory of the repository containing dir.
+func TopLevel(dir string) (string, error) {
+	out, err := Run(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Diff runs git diff with zero context lines and parses the result.
+// Extra arguments (e.g. "--staged" or "main..HEAD") are passed through to git.
+func Diff(dir string, args ...string) ([]FileDiff, error) {
+	gitArgs := append([]string{"diff", "--no-color", "--no-ext-diff", "--unified=0"}, args...)
+	out, err := Run(dir, gitArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiff(out), nil
+}
+
+// ShowFile returns the content of path at the given revision.
+// An empty rev reads the staged (index) version of the file.
+func ShowFile(dir, rev, path string) (string, error) {
+	return Run(dir, "show", rev+":"+path)
+}
+
+// ParseDiff parses unified diff output into per-file added line sets.
+// Deleted files and files without added lines are omitted.
+func ParseDiff(diff string) []FileDiff {
+	var files []FileDiff
+	var current *FileDiff
+	inHeader := true
+	newLine := 0
+
+	flush := func() {
+		if current != nil && len(current.AddedLines) > 0 {
+			files = append(files, *current)
+		}
+		current = nil
+	}
+
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			flush()
+			inHeader = true
+		case inHeader && strings.HasPrefix(line, "+++ "):
+			flush()
+			path := parseDiffPath(strings.TrimPrefix(line, "+++ "))
+			if path != "" {
+				current = &FileDiff{Path: path, AddedLines: make(map[int]struct{})}
+			}
+		case strings.HasPrefix(line, "@@"):
+			inHeader = false
+			newLine = parseHunkStart(line)
+		case inHeader || current == nil:
+			// File header lines or a file without new content
+		case strings.HasPrefix(line, "+"):
+			current.AddedLines[newLine] = struct{}{}
+			newLine++
+		case strings.HasPrefix(line, " "):
+			newLine++
+		}
+	}
+	flush()
+
+	return files
+}
+
+// parseDiffPath extracts the repository-relative path from a "+++" header value.
+// Returns empty string for /dev/null (deleted files).
+func parseDiffPath(value string) string {
+	value = strings.TrimSuffix(value, "\t")
+	if strings.HasPrefix(value, `"`) {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+	}
+	if value == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(value, "b/")
+}
+
+// parseHunkStart returns the first new-file line number from a hunk header
+// such as "@@ -10,2 +12,3 @@", or 0 if the header is malformed.
+func parseHunkStart(header string) int {
+	fields := strings.Fields(header)
+	for _, field := range fields {
+		if !strings.HasPrefix(field, "+") {
+			continue
+		}
+		start, _, _ := strings.Cut(strings.TrimPrefix(field, "+"), ",")
+		n, err := strconv.Atoi(start)
+		if err != nil {
+			return 0
+		}
+		return n
+	}
+	return 0
+}
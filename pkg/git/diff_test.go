@@ -0,0 +1,136 @@
+package git
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/git/gittest"
+)
+
+func Test_ParseDiff_SingleHunk_ReturnsAddedLines(t *testing.T) {
+	// given
+	diff := `diff --git a/app.py b/app.py
+index 1111111..2222222 100644
+--- a/app.py
++++ b/app.py
+@@ -2,0 +3,2 @@ def main():
++    # new comment
++    return 1
+`
+
+	// when
+	files := ParseDiff(diff)
+
+	// then
+	require.Len(t, files, 1)
+	assert.Equal(t, "app.py", files[0].Path)
+	assert.Equal(t, map[int]struct{}{3: {}, 4: {}}, files[0].AddedLines)
+}
+
+func Test_ParseDiff_MultipleFilesAndHunks_TracksEachFile(t *testing.T) {
+	// given
+	diff := `diff --git a/a.go b/a.go
+--- a/a.go
++++ b/a.go
+@@ -1 +1 @@
+-old
++new
+@@ -10,0 +11 @@
++// added
+diff --git a/src/b.ts b/src/b.ts
+new file mode 100644
+--- /dev/null
++++ b/src/b.ts
+@@ -0,0 +1,2 @@
++const x = 1;
++++y;
+`
+
+	// when
+	files := ParseDiff(diff)
+
+	// then
+	require.Len(t, files, 2)
+	assert.Equal(t, "a.go", files[0].Path)
+	assert.Equal(t, map[int]struct{}{1: {}, 11: {}}, files[0].AddedLines)
+	assert.Equal(t, "src/b.ts", files[1].Path)
+	assert.Equal(t, map[int]struct{}{1: {}, 2: {}}, files[1].AddedLines)
+}
+
+func Test_ParseDiff_DeletedFile_IsOmitted(t *testing.T) {
+	// given
+	diff := `diff --git a/gone.py b/gone.py
+deleted file mode 100644
+--- a/gone.py
++++ /dev/null
+@@ -1 +0,0 @@
+-# bye
+`
+
+	// when
+	files := ParseDiff(diff)
+
+	// then
+	assert.Empty(t, files)
+}
+
+func Test_ParseDiff_QuotedPath_IsUnquoted(t *testing.T) {
+	// given
+	diff := "--- a/x\n+++ \"b/dir/t\\tab.py\"\n@@ -0,0 +1 @@\n+x = 1\n"
+
+	// when
+	files := ParseDiff(diff)
+
+	// then
+	require.Len(t, files, 1)
+	assert.Equal(t, "dir/t\tab.py", files[0].Path)
+}
+
+func Test_Diff_StagedChanges_ReturnsAddedLines(t *testing.T) {
+	// given
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "app.py", "print(1)\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.Run(t, dir, "commit", "-q", "-m", "init")
+	gittest.WriteFile(t, dir, "app.py", "print(1)\n# added\n")
+	gittest.Run(t, dir, "add", ".")
+
+	// when
+	files, err := Diff(dir, "--staged")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "app.py", files[0].Path)
+	assert.Equal(t, map[int]struct{}{2: {}}, files[0].AddedLines)
+}
+
+func Test_ShowFile_EmptyRev_ReturnsStagedContent(t *testing.T) {
+	// given
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "app.py", "staged\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.WriteFile(t, dir, "app.py", "unstaged\n")
+
+	// when
+	content, err := ShowFile(dir, "", "app.py")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "staged\n", content)
+}
+
+func Test_TopLevel_OutsideRepository_ReturnsError(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	// when
+	_, err := TopLevel(dir)
+
+	// then
+	assert.Error(t, err)
+}
@@ -0,0 +1,39 @@
+// Package gittest provides git repository fixtures for tests.
+package gittest
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// InitRepo creates a git repository in a temporary directory with a committer
+// identity configured, and returns its path.
+func InitRepo(t testing.TB) string {
+	t.Helper()
+	dir := t.TempDir()
+	Run(t, dir, "init", "-q")
+	Run(t, dir, "config", "user.email", "test@example.com")
+	Run(t, dir, "config", "user.name", "test")
+	return dir
+}
+
+// Run runs a git command in dir and fails the test if it does not succeed.
+func Run(t testing.TB, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+// WriteFile writes content to name under dir, creating parent directories.
+func WriteFile(t testing.TB, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
@@ -9,19 +9,134 @@ const (
 	CommentTypeLine      CommentType = "line"
 	CommentTypeBlock     CommentType = "block"
 	CommentTypeDocstring CommentType = "docstring"
+	// CommentTypeStringComment is a string literal statement used as a comment.
+	CommentTypeStringComment CommentType = "string_comment"
+)
+
+// Severity is how strongly a finding is enforced.
+type Severity string
+
+const (
+	// SeverityError findings block the hook and fail scans.
+	SeverityError Severity = "error"
+	// SeverityWarning findings are passed to the agent as non-blocking context.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo findings are only listed in reports.
+	SeverityInfo Severity = "info"
+)
+
+// Severities lists every severity, most severe first.
+var Severities = []Severity{SeverityError, SeverityWarning, SeverityInfo}
+
+// Category is the kind of problem a finding represents.
+type Category string
+
+const (
+	CategoryComment          Category = "comment"
+	CategoryDocstring        Category = "docstring"
+	CategoryAgentMemo        Category = "agent_memo"
+	CategoryTodo             Category = "todo"
+	CategoryCommentedOutCode Category = "commented_out_code"
+	CategoryLicense          Category = "license"
+)
+
+// Categories lists every category.
+var Categories = []Category{
+	CategoryComment,
+	CategoryDocstring,
+	CategoryAgentMemo,
+	CategoryTodo,
+	CategoryCommentedOutCode,
+	CategoryLicense,
+}
+
+// Metadata keys attached to comments during detection and filtering.
+const (
+	// MetadataLanguage holds the tree-sitter language name of a comment.
+	MetadataLanguage = "language"
+	// MetadataAllowedBy holds the name of the filter that allowed a comment.
+	MetadataAllowedBy = "allowed_by"
+	// MetadataLineOrigin is set when positions are not relative to the whole file.
+	MetadataLineOrigin = "line_origin"
+	// MetadataSymbol holds the name of the declaration a doc comment is attached to.
+	MetadataSymbol = "symbol"
+	// MetadataPublicAPI is "true" or "false" for comments attached to a declaration,
+	// depending on whether the declaration is part of the public API.
+	MetadataPublicAPI = "public_api"
+	// MetadataScope holds the dot-separated names of the declarations enclosing a comment.
+	MetadataScope = "scope"
+	// MetadataPragma holds the kind of the comment-checker pragma that suppresses
+	// a comment: "allow", "allow-next-line", "disable" or "enable" (pragmas suppress themselves).
+	MetadataPragma = "pragma"
+	// MetadataPragmaReason holds the text following the suppressing pragma, if any.
+	MetadataPragmaReason = "pragma_reason"
+	// MetadataAgentMemo is "true" or "false" once a comment has been checked
+	// for memo-style wording such as "Changed from X to Y".
+	MetadataAgentMemo = "agent_memo"
+	// MetadataAgentMemoPattern holds the name of the agent memo pattern a memo matched.
+	MetadataAgentMemoPattern = "agent_memo_pattern"
+	// MetadataCommentedOutCode is "true" for comments whose body parses as code.
+	MetadataCommentedOutCode = "commented_out_code"
 )
 
 // CommentInfo holds information about a single comment in source code.
+// Lines and columns are 1-based; EndColumn points just past the last character.
+// Column and EndColumn count bytes, RuneColumn and RuneEndColumn count Unicode
+// code points. StartByte and EndByte are 0-based offsets of the half-open range
+// [StartByte, EndByte).
+// Category and Severity are set once a comment has been classified as a finding.
 type CommentInfo struct {
-	Text        string            `json:"text"`
-	LineNumber  int               `json:"line_number"`
-	FilePath    string            `json:"file_path"`
-	CommentType CommentType       `json:"comment_type"`
-	IsDocstring bool              `json:"is_docstring"`
-	Metadata    map[string]string `json:"metadata,omitempty"`
+	Text          string            `json:"text"`
+	LineNumber    int               `json:"line_number"`
+	Column        int               `json:"column,omitempty"`
+	EndLine       int               `json:"end_line,omitempty"`
+	EndColumn     int               `json:"end_column,omitempty"`
+	RuneColumn    int               `json:"rune_column,omitempty"`
+	RuneEndColumn int               `json:"rune_end_column,omitempty"`
+	StartByte     int               `json:"start_byte"`
+	EndByte       int               `json:"end_byte"`
+	FilePath      string            `json:"file_path"`
+	CommentType   CommentType       `json:"comment_type"`
+	IsDocstring   bool              `json:"is_docstring"`
+	Category      Category          `json:"category,omitempty"`
+	Severity      Severity          `json:"severity,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
 }
 
 // NormalizedText returns the comment text stripped of whitespace and lowercased.
 func (c *CommentInfo) NormalizedText() string {
 	return strings.ToLower(strings.TrimSpace(c.Text))
 }
+
+// LastLine returns the line the comment ends on, falling back to LineNumber
+// when no end position was recorded.
+func (c *CommentInfo) LastLine() int {
+	if c.EndLine > c.LineNumber {
+		return c.EndLine
+	}
+	return c.LineNumber
+}
+
+// IsCommentedOutCode returns true if the comment was classified as commented-out code.
+func (c *CommentInfo) IsCommentedOutCode() bool {
+	return c.Metadata[MetadataCommentedOutCode] == "true"
+}
+
+// IsError returns true if the comment is a finding that must block. Comments
+// without a severity are treated as errors.
+func (c *CommentInfo) IsError() bool {
+	return c.Severity == SeverityError || c.Severity == ""
+}
+
+// WithMetadata returns a copy of the comment with the metadata key set.
+// The original comment and its metadata map are left untouched.
+func (c *CommentInfo) WithMetadata(key, value string) CommentInfo {
+	metadata := make(map[string]string, len(c.Metadata)+1)
+	for k, v := range c.Metadata {
+		metadata[k] = v
+	}
+	metadata[key] = value
+	copied := *c
+	copied.Metadata = metadata
+	return copied
+}
@@ -71,3 +71,29 @@ func TestCommentInfo_GivenMetadata_StoresMetadata(t *testing.T) {
 	assert.Equal(t, "python", comment.Metadata["language"])
 	assert.Equal(t, "comment", comment.Metadata["node_type"])
 }
+
+func TestWithMetadata_GivenExistingMetadata_ReturnsCopyWithKey(t *testing.T) {
+	// given
+	original := CommentInfo{
+		Text:     "# comment",
+		Metadata: map[string]string{MetadataLanguage: "python"},
+	}
+
+	// when
+	updated := original.WithMetadata(MetadataAllowedBy, "bdd")
+
+	// then
+	assert.Equal(t, "bdd", updated.Metadata[MetadataAllowedBy])
+	assert.Equal(t, "python", updated.Metadata[MetadataLanguage])
+	assert.NotContains(t, original.Metadata, MetadataAllowedBy)
+}
+
+func TestLastLine_GivenNoEndLine_ReturnsLineNumber(t *testing.T) {
+	// given
+	single := CommentInfo{LineNumber: 4}
+	multi := CommentInfo{LineNumber: 4, EndLine: 9}
+
+	// when & then
+	assert.Equal(t, 4, single.LastLine())
+	assert.Equal(t, 9, multi.LastLine())
+}
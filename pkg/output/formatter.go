@@ -20,14 +20,7 @@ func FormatHookMessage(comments []models.CommentInfo, customPrompt string) strin
 	}
 
 	// Group comments by file path
-	byFile := make(map[string][]models.CommentInfo)
-	fileOrder := make([]string, 0)
-	for _, comment := range comments {
-		if _, exists := byFile[comment.FilePath]; !exists {
-			fileOrder = append(fileOrder, comment.FilePath)
-		}
-		byFile[comment.FilePath] = append(byFile[comment.FilePath], comment)
-	}
+	byFile, fileOrder := groupByFile(comments)
 
 	// Build comments XML
 	var commentsXML strings.Builder
@@ -43,22 +36,39 @@ func FormatHookMessage(comments []models.CommentInfo, customPrompt string) strin
 	}
 
 	// Default message template
-	// Detect agent memo comments
-	agentMemoFilter := filters.NewAgentMemoFilter()
+	// Collect agent memo comments
 	var agentMemoComments []models.CommentInfo
 	for _, comment := range comments {
-		if agentMemoFilter.IsAgentMemo(comment) {
+		if _, isMemo := filters.AgentMemoPattern(comment); isMemo {
 			agentMemoComments = append(agentMemoComments, comment)
 		}
 	}
 	hasAgentMemo := len(agentMemoComments) > 0
 
+	var commentedOutCode []models.CommentInfo
+	for _, comment := range comments {
+		if comment.IsCommentedOutCode() {
+			commentedOutCode = append(commentedOutCode, comment)
+		}
+	}
+	hasCommentedOutCode := len(commentedOutCode) > 0
+
+	var bareTodos []models.CommentInfo
+	for _, comment := range comments {
+		if todo, ok := filters.ParseTodo(comment.Text); ok && !todo.IsTracked() {
+			bareTodos = append(bareTodos, comment)
+		}
+	}
+
 	var sb strings.Builder
 
 	// Header
-	if hasAgentMemo {
+	switch {
+	case hasAgentMemo:
 		sb.WriteString("🚨 AGENT MEMO COMMENT DETECTED - CODE SMELL ALERT 🚨\n\n")
-	} else {
+	case hasCommentedOutCode:
+		sb.WriteString("COMMENTED-OUT CODE DETECTED - DELETE IT\n\n")
+	default:
 		sb.WriteString("COMMENT/DOCSTRING DETECTED - IMMEDIATE ACTION REQUIRED\n\n")
 	}
 
@@ -85,7 +95,37 @@ func FormatHookMessage(comments []models.CommentInfo, customPrompt string) strin
 		sb.WriteString("  -> Let git commit messages document the \"what\" and \"why\"\n\n")
 		sb.WriteString("Detected agent memo comments:\n")
 		for _, memo := range agentMemoComments {
-			sb.WriteString(fmt.Sprintf("  - Line %d: %s\n", memo.LineNumber, strings.TrimSpace(memo.Text)))
+			pattern, _ := filters.AgentMemoPattern(memo)
+			sb.WriteString(fmt.Sprintf("  - Line %d: %s (%s)\n", memo.LineNumber, strings.TrimSpace(memo.Text), pattern))
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	// Commented-out code warning (if detected)
+	if hasCommentedOutCode {
+		sb.WriteString("⚠️  COMMENTED-OUT CODE DETECTED  ⚠️\n\n")
+		sb.WriteString("These comments contain code that was disabled instead of deleted.\n")
+		sb.WriteString("Dead code in comments is never justified: git history already keeps the old version.\n\n")
+		sb.WriteString("ACTION REQUIRED:\n")
+		sb.WriteString("  -> DELETE the commented-out code, do not explain or justify it\n")
+		sb.WriteString("  -> If the code is still needed, restore it as real code\n\n")
+		sb.WriteString("Detected commented-out code:\n")
+		for _, comment := range commentedOutCode {
+			sb.WriteString(fmt.Sprintf("  - Line %d: %s\n", comment.LineNumber, strings.TrimSpace(comment.Text)))
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	// Bare TODO warning (if detected)
+	if len(bareTodos) > 0 {
+		sb.WriteString("⚠️  UNTRACKED TODO DETECTED  ⚠️\n\n")
+		sb.WriteString("TODO/FIXME/HACK/XXX/BUG comments without a ticket or an owner are never followed up.\n\n")
+		sb.WriteString("ACTION REQUIRED:\n")
+		sb.WriteString("  -> Do the work now and remove the marker\n")
+		sb.WriteString("  -> If it really cannot be done now, ask the user for a ticket and reference it, e.g. TODO(PROJ-123)\n\n")
+		sb.WriteString("Detected untracked TODOs:\n")
+		for _, comment := range bareTodos {
+			sb.WriteString(fmt.Sprintf("  - Line %d: %s\n", comment.LineNumber, strings.TrimSpace(comment.Text)))
 		}
 		sb.WriteString("\n---\n\n")
 	}
@@ -129,3 +169,16 @@ func FormatHookMessage(comments []models.CommentInfo, customPrompt string) strin
 
 	return sb.String()
 }
+
+// groupByFile groups comments by file path, preserving first-seen file order.
+func groupByFile(comments []models.CommentInfo) (map[string][]models.CommentInfo, []string) {
+	byFile := make(map[string][]models.CommentInfo)
+	fileOrder := make([]string, 0)
+	for _, comment := range comments {
+		if _, exists := byFile[comment.FilePath]; !exists {
+			fileOrder = append(fileOrder, comment.FilePath)
+		}
+		byFile[comment.FilePath] = append(byFile[comment.FilePath], comment)
+	}
+	return byFile, fileOrder
+}
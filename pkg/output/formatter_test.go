@@ -178,3 +178,122 @@ func Test_FormatHookMessage_CustomPrompt_WithoutPlaceholder_ReturnsCustomOnly(t
 	// then
 	assert.Equal(t, "Simple warning without placeholder.", result)
 }
+
+func Test_FormatHookMessage_MultiLineComment_IncludesEndLineNumber(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{
+			Text:        "/* first\n   last */",
+			LineNumber:  10,
+			EndLine:     11,
+			FilePath:    "src/app.c",
+			CommentType: models.CommentTypeBlock,
+		},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.Contains(t, result, "<comment line-number=\"10\" end-line-number=\"11\">/* first\n   last */</comment>")
+}
+
+func Test_FormatHookMessage_SnippetLineOrigin_IsMarked(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{
+			Text:        "// note",
+			LineNumber:  2,
+			FilePath:    "src/app.go",
+			CommentType: models.CommentTypeLine,
+			Metadata:    map[string]string{models.MetadataLineOrigin: "snippet"},
+		},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.Contains(t, result, "<comment line-number=\"2\" line-origin=\"snippet\">// note</comment>")
+}
+
+func Test_FormatHookMessage_WarningFinding_IsMarkedWithSeverity(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// Doc.", LineNumber: 1, FilePath: "a.go", Severity: models.SeverityWarning},
+		{Text: "// memo", LineNumber: 2, FilePath: "a.go", Severity: models.SeverityError},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.Contains(t, result, "<comment line-number=\"1\" severity=\"warning\">// Doc.</comment>")
+	assert.Contains(t, result, "<comment line-number=\"2\">// memo</comment>")
+}
+
+func Test_FormatHookMessage_AgentMemo_ListsMatchedPattern(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# Changed from list to set", LineNumber: 4, FilePath: "a.py", CommentType: models.CommentTypeLine,
+			Metadata: map[string]string{models.MetadataAgentMemo: "true", models.MetadataAgentMemoPattern: "changed-from-to"}},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.Contains(t, result, "AGENT MEMO COMMENT DETECTED")
+	assert.Contains(t, result, "  - Line 4: # Changed from list to set (changed-from-to)\n")
+}
+
+func Test_FormatHookMessage_CommentedOutCode_HasOwnSection(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// cache the result", LineNumber: 3, FilePath: "src/app.go", CommentType: models.CommentTypeLine},
+		{
+			Text:        "// fmt.Println(\"debug\")",
+			LineNumber:  5,
+			FilePath:    "src/app.go",
+			CommentType: models.CommentTypeLine,
+			Metadata:    map[string]string{models.MetadataCommentedOutCode: "true"},
+		},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.True(t, strings.HasPrefix(result, "COMMENTED-OUT CODE DETECTED"))
+	assert.Contains(t, result, "Detected commented-out code:\n  - Line 5: // fmt.Println(\"debug\")\n")
+	assert.NotContains(t, result, "Line 3: // cache the result")
+	assert.Contains(t, result, "<comment line-number=\"5\" commented-out-code=\"true\">// fmt.Println(\"debug\")</comment>")
+}
+
+func Test_FormatHookMessage_BareTodo_HasOwnSection(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// TODO: fix later", LineNumber: 3, FilePath: "src/app.go", CommentType: models.CommentTypeLine},
+		{Text: "// TODO(PROJ-9): drop v1", LineNumber: 7, FilePath: "src/app.go", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.Contains(t, result, "Detected untracked TODOs:\n  - Line 3: // TODO: fix later\n\n")
+	assert.NotContains(t, result, "Line 7: // TODO(PROJ-9)")
+}
+
+func Test_FormatHookMessage_NoTodo_OmitsTodoSection(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// cache the result", LineNumber: 3, FilePath: "src/app.go", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result := FormatHookMessage(comments, "")
+
+	// then
+	assert.NotContains(t, result, "UNTRACKED TODO")
+}
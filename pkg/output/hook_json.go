@@ -0,0 +1,68 @@
+package output
+
+import (
+	"encoding/json"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// DefaultHookEventName is used when the hook input carries no event name.
+const DefaultHookEventName = "PostToolUse"
+
+// PreToolUseEventName is the hook event fired before a tool call runs.
+const PreToolUseEventName = "PreToolUse"
+
+// HookOutput is the JSON object Claude Code accepts from a hook on stdout.
+type HookOutput struct {
+	Decision           string               `json:"decision,omitempty"`
+	Reason             string               `json:"reason,omitempty"`
+	HookSpecificOutput *HookSpecificOutput  `json:"hookSpecificOutput,omitempty"`
+	Comments           []models.CommentInfo `json:"comments,omitempty"`
+}
+
+// HookSpecificOutput holds event-specific fields of a HookOutput.
+type HookSpecificOutput struct {
+	HookEventName            string `json:"hookEventName"`
+	AdditionalContext        string `json:"additionalContext,omitempty"`
+	PermissionDecision       string `json:"permissionDecision,omitempty"`
+	PermissionDecisionReason string `json:"permissionDecisionReason,omitempty"`
+}
+
+// BuildHookOutput builds the structured hook response for the given comments.
+// A blocking response sets decision "block" with the formatted message as the reason.
+// For PreToolUse it denies the tool call via permissionDecision instead, so the
+// write never happens. A non-blocking response passes the message to the agent
+// as additional context only.
+func BuildHookOutput(comments []models.CommentInfo, customPrompt, hookEventName string, blocking bool) HookOutput {
+	if hookEventName == "" {
+		hookEventName = DefaultHookEventName
+	}
+
+	message := FormatHookMessage(comments, customPrompt)
+	result := HookOutput{
+		HookSpecificOutput: &HookSpecificOutput{HookEventName: hookEventName},
+		Comments:           comments,
+	}
+
+	switch {
+	case blocking && hookEventName == PreToolUseEventName:
+		result.HookSpecificOutput.PermissionDecision = "deny"
+		result.HookSpecificOutput.PermissionDecisionReason = message
+	case blocking:
+		result.Decision = "block"
+		result.Reason = message
+	default:
+		result.HookSpecificOutput.AdditionalContext = message
+	}
+
+	return result
+}
+
+// FormatHookJSON marshals the structured hook response for the given comments.
+func FormatHookJSON(comments []models.CommentInfo, customPrompt, hookEventName string, blocking bool) (string, error) {
+	data, err := json.Marshal(BuildHookOutput(comments, customPrompt, hookEventName, blocking))
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,79 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_BuildHookOutput_Blocking_SetsDecisionAndReason(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# comment", LineNumber: 3, FilePath: "app.py", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result := BuildHookOutput(comments, "", "", true)
+
+	// then
+	assert.Equal(t, "block", result.Decision)
+	assert.Contains(t, result.Reason, `<comment line-number="3"># comment</comment>`)
+	require.NotNil(t, result.HookSpecificOutput)
+	assert.Equal(t, "PostToolUse", result.HookSpecificOutput.HookEventName)
+	assert.Empty(t, result.HookSpecificOutput.AdditionalContext)
+	assert.Equal(t, comments, result.Comments)
+}
+
+func Test_BuildHookOutput_NonBlocking_UsesAdditionalContext(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# comment", LineNumber: 3, FilePath: "app.py", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result := BuildHookOutput(comments, "Remove: {{comments}}", "PostToolUse", false)
+
+	// then
+	assert.Empty(t, result.Decision)
+	assert.Empty(t, result.Reason)
+	assert.Contains(t, result.HookSpecificOutput.AdditionalContext, "Remove: <comments file=\"app.py\">")
+}
+
+func Test_BuildHookOutput_PreToolUse_DeniesPermission(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# comment", LineNumber: 1, FilePath: "app.py", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result := BuildHookOutput(comments, "", PreToolUseEventName, true)
+
+	// then
+	assert.Empty(t, result.Decision)
+	assert.Equal(t, "PreToolUse", result.HookSpecificOutput.HookEventName)
+	assert.Equal(t, "deny", result.HookSpecificOutput.PermissionDecision)
+	assert.Contains(t, result.HookSpecificOutput.PermissionDecisionReason, "# comment")
+}
+
+func Test_FormatHookJSON_UsesClaudeCodeFieldNames(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// c", LineNumber: 1, FilePath: "a.go", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result, err := FormatHookJSON(comments, "", "PostToolUse", true)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &decoded))
+
+	// then
+	assert.Equal(t, "block", decoded["decision"])
+	assert.Contains(t, decoded, "reason")
+	assert.Contains(t, decoded, "hookSpecificOutput")
+	assert.Len(t, decoded["comments"], 1)
+}
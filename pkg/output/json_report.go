@@ -0,0 +1,122 @@
+package output
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/filters"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// JSONReportVersion is the schema version of JSONReport.
+// It is incremented whenever a field is removed or changes meaning.
+const JSONReportVersion = 1
+
+// JSONReport is the machine-readable scan report.
+type JSONReport struct {
+	Version int          `json:"version"`
+	Summary JSONSummary  `json:"summary"`
+	Results []JSONResult `json:"results"`
+}
+
+// JSONSummary holds aggregate counts of a JSONReport.
+type JSONSummary struct {
+	Files    int `json:"files"`
+	Findings int `json:"findings"`
+	Allowed  int `json:"allowed"`
+}
+
+// JSONResult describes a single detected comment.
+// AllowedBy names the filter that allowed the comment and is empty for findings.
+// Severity is only set for findings.
+// AgentMemoPattern names the agent memo pattern the comment matched, if any.
+// Pragma and PragmaReason describe the comment-checker pragma covering the comment, if any.
+type JSONResult struct {
+	File             string             `json:"file"`
+	Line             int                `json:"line"`
+	Column           int                `json:"column"`
+	EndLine          int                `json:"end_line"`
+	EndColumn        int                `json:"end_column"`
+	StartByte        int                `json:"start_byte"`
+	EndByte          int                `json:"end_byte"`
+	Text             string             `json:"text"`
+	CommentType      models.CommentType `json:"comment_type"`
+	IsDocstring      bool               `json:"is_docstring"`
+	Category         models.Category    `json:"category"`
+	Severity         models.Severity    `json:"severity,omitempty"`
+	Flagged          bool               `json:"flagged"`
+	AllowedBy        string             `json:"allowed_by"`
+	AgentMemo        bool               `json:"agent_memo"`
+	AgentMemoPattern string             `json:"agent_memo_pattern"`
+	CommentedOutCode bool               `json:"commented_out_code"`
+	Pragma           string             `json:"pragma"`
+	PragmaReason     string             `json:"pragma_reason"`
+}
+
+// BuildJSONReport builds a report from flagged and allowed comments.
+// Allowed comments are expected to carry the allowing filter in their metadata.
+// Results are ordered by file, line and column.
+func BuildJSONReport(flagged, allowed []models.CommentInfo, fileCount int) JSONReport {
+	results := make([]JSONResult, 0, len(flagged)+len(allowed))
+	for _, comment := range flagged {
+		results = append(results, newJSONResult(comment))
+	}
+	for _, comment := range allowed {
+		results = append(results, newJSONResult(comment))
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].File != results[j].File {
+			return results[i].File < results[j].File
+		}
+		if results[i].Line != results[j].Line {
+			return results[i].Line < results[j].Line
+		}
+		return results[i].Column < results[j].Column
+	})
+
+	return JSONReport{
+		Version: JSONReportVersion,
+		Summary: JSONSummary{
+			Files:    fileCount,
+			Findings: len(flagged),
+			Allowed:  len(allowed),
+		},
+		Results: results,
+	}
+}
+
+// FormatJSONReport marshals the scan report as indented JSON.
+func FormatJSONReport(flagged, allowed []models.CommentInfo, fileCount int) (string, error) {
+	data, err := json.MarshalIndent(BuildJSONReport(flagged, allowed, fileCount), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+func newJSONResult(comment models.CommentInfo) JSONResult {
+	allowedBy := comment.Metadata[models.MetadataAllowedBy]
+	memoPattern, isMemo := filters.AgentMemoPattern(comment)
+	return JSONResult{
+		File:             comment.FilePath,
+		Line:             comment.LineNumber,
+		Column:           comment.Column,
+		EndLine:          comment.LastLine(),
+		EndColumn:        comment.EndColumn,
+		StartByte:        comment.StartByte,
+		EndByte:          comment.EndByte,
+		Text:             comment.Text,
+		CommentType:      comment.CommentType,
+		IsDocstring:      comment.IsDocstring,
+		Category:         comment.Category,
+		Severity:         comment.Severity,
+		Flagged:          allowedBy == "",
+		AllowedBy:        allowedBy,
+		AgentMemo:        isMemo,
+		AgentMemoPattern: memoPattern,
+		CommentedOutCode: comment.IsCommentedOutCode(),
+		Pragma:           comment.Metadata[models.MetadataPragma],
+		PragmaReason:     comment.Metadata[models.MetadataPragmaReason],
+	}
+}
@@ -0,0 +1,98 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_BuildJSONReport_FlaggedAndAllowed_SortedWithVerdicts(t *testing.T) {
+	// given
+	flagged := []models.CommentInfo{
+		{Text: "# Added retry logic", LineNumber: 9, Column: 5, FilePath: "a.py", CommentType: models.CommentTypeLine},
+	}
+	allowed := []models.CommentInfo{
+		{Text: "# given", LineNumber: 2, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine,
+			Metadata: map[string]string{models.MetadataAllowedBy: "bdd"}},
+	}
+
+	// when
+	report := BuildJSONReport(flagged, allowed, 1)
+
+	// then
+	assert.Equal(t, JSONReportVersion, report.Version)
+	assert.Equal(t, JSONSummary{Files: 1, Findings: 1, Allowed: 1}, report.Summary)
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, 2, report.Results[0].Line)
+	assert.False(t, report.Results[0].Flagged)
+	assert.Equal(t, "bdd", report.Results[0].AllowedBy)
+	assert.Equal(t, 9, report.Results[1].Line)
+	assert.Equal(t, 5, report.Results[1].Column)
+	assert.True(t, report.Results[1].Flagged)
+	assert.True(t, report.Results[1].AgentMemo)
+	assert.Equal(t, "added", report.Results[1].AgentMemoPattern)
+}
+
+func Test_BuildJSONReport_RecordedMemoVerdict_IsUsed(t *testing.T) {
+	// given
+	flagged := []models.CommentInfo{
+		{Text: "# Added retry logic", LineNumber: 1, FilePath: "a.py",
+			Metadata: map[string]string{models.MetadataAgentMemo: "false"}},
+		{Text: "# retry logic", LineNumber: 2, FilePath: "a.py",
+			Metadata: map[string]string{models.MetadataAgentMemo: "true", models.MetadataAgentMemoPattern: "custom"}},
+	}
+
+	// when
+	report := BuildJSONReport(flagged, nil, 1)
+
+	// then
+	require.Len(t, report.Results, 2)
+	assert.False(t, report.Results[0].AgentMemo)
+	assert.Empty(t, report.Results[0].AgentMemoPattern)
+	assert.True(t, report.Results[1].AgentMemo)
+	assert.Equal(t, "custom", report.Results[1].AgentMemoPattern)
+}
+
+func Test_FormatJSONReport_NoComments_EmitsEmptyResultsArray(t *testing.T) {
+	// given
+	var flagged, allowed []models.CommentInfo
+
+	// when
+	result, err := FormatJSONReport(flagged, allowed, 3)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &decoded))
+
+	// then
+	assert.Equal(t, float64(1), decoded["version"])
+	assert.Equal(t, []any{}, decoded["results"])
+}
+
+func Test_FormatJSONReport_ResultFieldNames_AreStable(t *testing.T) {
+	// given
+	flagged := []models.CommentInfo{
+		{Text: `"""Doc."""`, LineNumber: 1, Column: 1, FilePath: "m.py", CommentType: models.CommentTypeDocstring, IsDocstring: true,
+			Category: models.CategoryDocstring, Severity: models.SeverityWarning},
+	}
+
+	// when
+	result, err := FormatJSONReport(flagged, nil, 1)
+	require.NoError(t, err)
+	var decoded struct {
+		Results []map[string]any `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result), &decoded))
+
+	// then
+	require.Len(t, decoded.Results, 1)
+	for _, key := range []string{"file", "line", "column", "text", "comment_type", "is_docstring", "category", "severity", "flagged", "allowed_by", "agent_memo", "agent_memo_pattern", "commented_out_code"} {
+		assert.Contains(t, decoded.Results[0], key)
+	}
+	assert.Equal(t, "docstring", decoded.Results[0]["comment_type"])
+	assert.Equal(t, "docstring", decoded.Results[0]["category"])
+	assert.Equal(t, "warning", decoded.Results[0]["severity"])
+}
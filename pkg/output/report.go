@@ -0,0 +1,83 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+// FormatScanReport formats scan results as a per-file human-readable report.
+// Each file is listed once, followed by its comments with line numbers (or line ranges
+// for multi-line comments) and a summary line. Non-error findings are tagged with their severity.
+// Returns empty string if no comments provided.
+func FormatScanReport(comments []models.CommentInfo) string {
+	if len(comments) == 0 {
+		return ""
+	}
+
+	byFile, fileOrder := groupByFile(comments)
+
+	var sb strings.Builder
+	for _, filePath := range fileOrder {
+		sb.WriteString(filePath)
+		sb.WriteString("\n")
+		for _, comment := range byFile[filePath] {
+			sb.WriteString(fmt.Sprintf("\t%s: %s%s\n", lineRange(comment), strings.TrimSpace(comment.Text), severityTag(comment)))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf("Found %d problematic comment(s)/docstring(s) in %d file(s)\n", len(comments), len(fileOrder)))
+
+	return sb.String()
+}
+
+// lineRange formats a comment's line, or its first and last line if it spans several.
+func lineRange(comment models.CommentInfo) string {
+	if comment.LastLine() > comment.LineNumber {
+		return fmt.Sprintf("%d-%d", comment.LineNumber, comment.LastLine())
+	}
+	return fmt.Sprintf("%d", comment.LineNumber)
+}
+
+// severityTag marks findings that do not fail the check, e.g. " [warning]".
+// Errors are left unmarked.
+func severityTag(comment models.CommentInfo) string {
+	if comment.IsError() {
+		return ""
+	}
+	return " [" + string(comment.Severity) + "]"
+}
+
+// FormatCompactReport formats comments as one "file:line: comment" entry per line.
+// Multi-line comments are shortened to their first line and non-error findings
+// are tagged with their severity.
+func FormatCompactReport(comments []models.CommentInfo) string {
+	var sb strings.Builder
+	for _, comment := range comments {
+		text, _, _ := strings.Cut(strings.TrimSpace(comment.Text), "\n")
+		sb.WriteString(fmt.Sprintf("%s:%d: %s%s\n", comment.FilePath, comment.LineNumber, strings.TrimSpace(text), severityTag(comment)))
+	}
+	return sb.String()
+}
+
+// FormatSuppressionReport lists comments suppressed by comment-checker pragmas
+// as "file:line: comment [pragma: reason]" entries for auditing.
+// Returns empty string if no comments provided.
+func FormatSuppressionReport(comments []models.CommentInfo) string {
+	if len(comments) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Suppressed %d comment(s) with pragmas:\n", len(comments)))
+	for _, comment := range comments {
+		text, _, _ := strings.Cut(strings.TrimSpace(comment.Text), "\n")
+		pragma := comment.Metadata[models.MetadataPragma]
+		if reason := comment.Metadata[models.MetadataPragmaReason]; reason != "" {
+			pragma += ": " + reason
+		}
+		sb.WriteString(fmt.Sprintf("%s:%s: %s [%s]\n", comment.FilePath, lineRange(comment), strings.TrimSpace(text), pragma))
+	}
+	return sb.String()
+}
@@ -0,0 +1,94 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FormatScanReport_MultipleFiles_GroupsByFile(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# First", LineNumber: 3, FilePath: "a.py", CommentType: models.CommentTypeLine},
+		{Text: "// Second", LineNumber: 7, FilePath: "b.go", CommentType: models.CommentTypeLine},
+		{Text: "# Third", LineNumber: 9, FilePath: "a.py", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	result := FormatScanReport(comments)
+
+	// then
+	assert.Equal(t, 1, strings.Count(result, "a.py\n"))
+	assert.Contains(t, result, "a.py\n\t3: # First\n\t9: # Third\n")
+	assert.Contains(t, result, "b.go\n\t7: // Second\n")
+	assert.Contains(t, result, "Found 3 problematic comment(s)/docstring(s) in 2 file(s)")
+}
+
+func Test_FormatScanReport_MultiLineComment_ShowsLineRange(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "/* a\n b */", LineNumber: 4, EndLine: 5, FilePath: "x.c", CommentType: models.CommentTypeBlock},
+	}
+
+	// when
+	result := FormatScanReport(comments)
+
+	// then
+	assert.Contains(t, result, "\t4-5: /* a\n b */\n")
+}
+
+func Test_FormatScanReport_EmptyList_ReturnsEmptyString(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{}
+
+	// when
+	result := FormatScanReport(comments)
+
+	// then
+	assert.Equal(t, "", result)
+}
+
+func Test_FormatCompactReport_BlockComment_UsesFirstLine(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# single", LineNumber: 2, FilePath: "a.py", CommentType: models.CommentTypeLine},
+		{Text: "/* first line\n * second line */", LineNumber: 5, FilePath: "b.c", CommentType: models.CommentTypeBlock},
+	}
+
+	// when
+	result := FormatCompactReport(comments)
+
+	// then
+	assert.Equal(t, "a.py:2: # single\nb.c:5: /* first line\n", result)
+}
+
+func Test_FormatCompactReport_NonErrorFindings_AreTaggedWithSeverity(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// memo", LineNumber: 1, FilePath: "a.go", Severity: models.SeverityError},
+		{Text: "// Doc.", LineNumber: 2, FilePath: "a.go", Severity: models.SeverityWarning},
+		{Text: "// TODO: later", LineNumber: 3, FilePath: "a.go", Severity: models.SeverityInfo},
+	}
+
+	// when
+	result := FormatCompactReport(comments)
+
+	// then
+	assert.Equal(t, "a.go:1: // memo\na.go:2: // Doc. [warning]\na.go:3: // TODO: later [info]\n", result)
+}
+
+func Test_FormatSuppressionReport_ListsPragmaAndReason(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// rationale", LineNumber: 3, FilePath: "a.go", Metadata: map[string]string{models.MetadataPragma: "allow", models.MetadataPragmaReason: "SEC-12"}},
+		{Text: "/* vendored\n   code */", LineNumber: 8, EndLine: 9, FilePath: "b.go", Metadata: map[string]string{models.MetadataPragma: "disable"}},
+	}
+
+	// when
+	result := FormatSuppressionReport(comments)
+
+	// then
+	assert.Equal(t, "Suppressed 2 comment(s) with pragmas:\na.go:3: // rationale [allow: SEC-12]\nb.go:8-9: /* vendored [disable]\n", result)
+}
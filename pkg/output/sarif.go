@@ -0,0 +1,283 @@
+package output
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/filters"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "comment-checker"
+	toolInfoURI  = "https://github.com/code-yeongyu/go-claude-code-comment-checker"
+	// sarifColumnKind matches the code point columns the regions report.
+	sarifColumnKind = "unicodeCodePoints"
+)
+
+// SARIF rule IDs assigned to findings, one per finding category.
+const (
+	RuleIDComment          = "comment"
+	RuleIDDocstring        = "docstring"
+	RuleIDAgentMemo        = "agent-memo"
+	RuleIDCommentedOutCode = "commented-out-code"
+	RuleIDTodo             = "todo"
+	RuleIDLicense          = "license"
+)
+
+// SARIFLog is the root object of a SARIF 2.1.0 document.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun is a single analysis run. ColumnKind states how region columns
+// are counted.
+type SARIFRun struct {
+	Tool       SARIFTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []SARIFResult `json:"results"`
+}
+
+// SARIFTool describes the analysis tool.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver describes the tool component and its rules.
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+// SARIFRule describes a reporting rule.
+type SARIFRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     SARIFMessage       `json:"shortDescription"`
+	DefaultConfiguration SARIFConfiguration `json:"defaultConfiguration"`
+}
+
+// SARIFConfiguration holds a rule's default level.
+type SARIFConfiguration struct {
+	Level string `json:"level"`
+}
+
+// SARIFMessage is a plain text message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFResult is a single finding.
+type SARIFResult struct {
+	RuleID       string             `json:"ruleId"`
+	RuleIndex    int                `json:"ruleIndex"`
+	Level        string             `json:"level"`
+	Message      SARIFMessage       `json:"message"`
+	Locations    []SARIFLocation    `json:"locations"`
+	Suppressions []SARIFSuppression `json:"suppressions,omitempty"`
+	Properties   *SARIFProperties   `json:"properties,omitempty"`
+}
+
+// SARIFProperties is the property bag of a result.
+type SARIFProperties struct {
+	// AgentMemoPattern names the agent memo pattern an agent-memo result matched.
+	AgentMemoPattern string `json:"agentMemoPattern,omitempty"`
+}
+
+// SARIFSuppression records that a result was suppressed in source by a pragma.
+type SARIFSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// SARIFLocation wraps a physical location.
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+// SARIFPhysicalLocation points at a region of a file.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           SARIFRegion           `json:"region"`
+}
+
+// SARIFArtifactLocation identifies a file.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFRegion is a 1-based line and column range with its source snippet.
+// Columns count Unicode code points and EndColumn is exclusive, as
+// RuneColumn and RuneEndColumn in models.CommentInfo.
+type SARIFRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	EndLine     int           `json:"endLine,omitempty"`
+	EndColumn   int           `json:"endColumn,omitempty"`
+	Snippet     *SARIFMessage `json:"snippet,omitempty"`
+}
+
+var sarifRules = []SARIFRule{
+	{
+		ID:                   RuleIDComment,
+		Name:                 "UnnecessaryComment",
+		ShortDescription:     SARIFMessage{Text: "Comment that should be removed or replaced by self-documenting code"},
+		DefaultConfiguration: SARIFConfiguration{Level: "error"},
+	},
+	{
+		ID:                   RuleIDDocstring,
+		Name:                 "UnnecessaryDocstring",
+		ShortDescription:     SARIFMessage{Text: "Docstring that is not essential documentation"},
+		DefaultConfiguration: SARIFConfiguration{Level: "error"},
+	},
+	{
+		ID:                   RuleIDAgentMemo,
+		Name:                 "AgentMemoComment",
+		ShortDescription:     SARIFMessage{Text: "Memo-style comment describing what was changed instead of what the code does"},
+		DefaultConfiguration: SARIFConfiguration{Level: "error"},
+	},
+	{
+		ID:                   RuleIDCommentedOutCode,
+		Name:                 "CommentedOutCode",
+		ShortDescription:     SARIFMessage{Text: "Commented-out code that should be deleted"},
+		DefaultConfiguration: SARIFConfiguration{Level: "error"},
+	},
+	{
+		ID:                   RuleIDTodo,
+		Name:                 "TodoComment",
+		ShortDescription:     SARIFMessage{Text: "TODO comment left in the code"},
+		DefaultConfiguration: SARIFConfiguration{Level: "error"},
+	},
+	{
+		ID:                   RuleIDLicense,
+		Name:                 "LicenseComment",
+		ShortDescription:     SARIFMessage{Text: "License or copyright header"},
+		DefaultConfiguration: SARIFConfiguration{Level: "error"},
+	},
+}
+
+// sarifRuleIndexes maps each finding category to its rule in sarifRules.
+var sarifRuleIndexes = map[models.Category]int{
+	models.CategoryComment:          0,
+	models.CategoryDocstring:        1,
+	models.CategoryAgentMemo:        2,
+	models.CategoryCommentedOutCode: 3,
+	models.CategoryTodo:             4,
+	models.CategoryLicense:          5,
+}
+
+// BuildSARIF builds a SARIF 2.1.0 log with one result per comment. Comments
+// allowed by the pragma filter are emitted as in-source suppressed results.
+func BuildSARIF(comments []models.CommentInfo, toolVersion string) SARIFLog {
+	results := make([]SARIFResult, 0, len(comments))
+	for _, comment := range comments {
+		ruleIndex := sarifRuleIndex(comment)
+		rule := sarifRules[ruleIndex]
+		var properties *SARIFProperties
+		if pattern, isMemo := filters.AgentMemoPattern(comment); isMemo && rule.ID == RuleIDAgentMemo {
+			properties = &SARIFProperties{AgentMemoPattern: pattern}
+		}
+		var suppressions []SARIFSuppression
+		if comment.Metadata[models.MetadataAllowedBy] == filters.PragmaFilterName {
+			suppressions = []SARIFSuppression{{Kind: "inSource", Justification: comment.Metadata[models.MetadataPragmaReason]}}
+		}
+		results = append(results, SARIFResult{
+			RuleID:    rule.ID,
+			RuleIndex: ruleIndex,
+			Level:     sarifLevel(comment, rule),
+			Message:   SARIFMessage{Text: rule.ShortDescription.Text + ": " + strings.TrimSpace(comment.Text)},
+			Locations: []SARIFLocation{{
+				PhysicalLocation: SARIFPhysicalLocation{
+					ArtifactLocation: SARIFArtifactLocation{URI: sarifURI(comment.FilePath)},
+					Region: SARIFRegion{
+						StartLine:   comment.LineNumber,
+						StartColumn: codePointColumn(comment.RuneColumn, comment.Column),
+						EndLine:     comment.LastLine(),
+						EndColumn:   codePointColumn(comment.RuneEndColumn, comment.EndColumn),
+						Snippet:     &SARIFMessage{Text: comment.Text},
+					},
+				},
+			}},
+			Suppressions: suppressions,
+			Properties:   properties,
+		})
+	}
+
+	return SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []SARIFRun{{
+			Tool: SARIFTool{Driver: SARIFDriver{
+				Name:           toolName,
+				Version:        toolVersion,
+				InformationURI: toolInfoURI,
+				Rules:          sarifRules,
+			}},
+			ColumnKind: sarifColumnKind,
+			Results:    results,
+		}},
+	}
+}
+
+// FormatSARIF marshals the SARIF log for the given comments as indented JSON.
+func FormatSARIF(comments []models.CommentInfo, toolVersion string) (string, error) {
+	data, err := json.MarshalIndent(BuildSARIF(comments, toolVersion), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+// sarifRuleIndex returns the index in sarifRules of the rule for a comment's
+// category, categorizing comments that do not carry one yet.
+func sarifRuleIndex(comment models.CommentInfo) int {
+	category := comment.Category
+	if category == "" {
+		category = filters.Categorize(comment)
+	}
+	return sarifRuleIndexes[category]
+}
+
+// sarifLevel returns the SARIF level of a comment's severity, falling back to
+// the rule's default level for comments without one.
+func sarifLevel(comment models.CommentInfo, rule SARIFRule) string {
+	switch comment.Severity {
+	case models.SeverityError:
+		return "error"
+	case models.SeverityWarning:
+		return "warning"
+	case models.SeverityInfo:
+		return "note"
+	default:
+		return rule.DefaultConfiguration.Level
+	}
+}
+
+// codePointColumn returns the code point column, falling back to the byte
+// column for comments built without one, where the two only differ beyond ASCII.
+func codePointColumn(runeColumn, byteColumn int) int {
+	if runeColumn > 0 {
+		return runeColumn
+	}
+	return byteColumn
+}
+
+// sarifURI converts a file path to a SARIF artifact URI.
+// Relative paths stay relative so code scanning resolves them against the repository root.
+func sarifURI(filePath string) string {
+	uri := filepath.ToSlash(filePath)
+	if filepath.IsAbs(filePath) {
+		if !strings.HasPrefix(uri, "/") {
+			uri = "/" + uri
+		}
+		return "file://" + uri
+	}
+	return strings.TrimPrefix(uri, "./")
+}
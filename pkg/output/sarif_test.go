@@ -0,0 +1,163 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
+)
+
+func Test_BuildSARIF_AssignsRuleIDsByKind(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# plain", LineNumber: 1, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine},
+		{Text: `"""Doc."""`, LineNumber: 2, Column: 5, FilePath: "a.py", CommentType: models.CommentTypeDocstring, IsDocstring: true},
+		{Text: "# Refactored for speed", LineNumber: 3, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine},
+		{Text: "# print(x)", LineNumber: 4, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine,
+			Metadata: map[string]string{models.MetadataCommentedOutCode: "true"}},
+		{Text: "# TODO: cache this", LineNumber: 5, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine},
+		{Text: "# Copyright 2024 Acme Inc.", LineNumber: 6, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	log := BuildSARIF(comments, "1.2.3")
+
+	// then
+	require.Len(t, log.Runs, 1)
+	results := log.Runs[0].Results
+	require.Len(t, results, 6)
+	assert.Equal(t, RuleIDComment, results[0].RuleID)
+	assert.Equal(t, RuleIDDocstring, results[1].RuleID)
+	assert.Equal(t, RuleIDAgentMemo, results[2].RuleID)
+	assert.Equal(t, &SARIFProperties{AgentMemoPattern: "refactored"}, results[2].Properties)
+	assert.Equal(t, RuleIDCommentedOutCode, results[3].RuleID)
+	assert.Equal(t, RuleIDTodo, results[4].RuleID)
+	assert.Equal(t, RuleIDLicense, results[5].RuleID)
+	assert.Nil(t, results[0].Properties)
+	for _, result := range results {
+		assert.Equal(t, result.RuleID, log.Runs[0].Tool.Driver.Rules[result.RuleIndex].ID)
+	}
+	assert.Equal(t, "1.2.3", log.Runs[0].Tool.Driver.Version)
+}
+
+func Test_BuildSARIF_RegionUsesCodePointColumns(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// note here", LineNumber: 3, Column: 24, EndColumn: 36, RuneColumn: 16, RuneEndColumn: 28, FilePath: "main.go", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	log := BuildSARIF(comments, "")
+
+	// then
+	assert.Equal(t, "unicodeCodePoints", log.Runs[0].ColumnKind)
+	region := log.Runs[0].Results[0].Locations[0].PhysicalLocation.Region
+	assert.Equal(t, 16, region.StartColumn)
+	assert.Equal(t, 28, region.EndColumn)
+}
+
+func Test_BuildSARIF_UsesRecordedCategory(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "# Refactored: print(x)", LineNumber: 1, Column: 1, FilePath: "a.py", CommentType: models.CommentTypeLine,
+			Category: models.CategoryAgentMemo, Metadata: map[string]string{models.MetadataCommentedOutCode: "true"}},
+	}
+
+	// when
+	log := BuildSARIF(comments, "")
+
+	// then
+	assert.Equal(t, RuleIDAgentMemo, log.Runs[0].Results[0].RuleID)
+}
+
+func Test_BuildSARIF_EveryCategoryHasARule(t *testing.T) {
+	for _, category := range models.Categories {
+		// when
+		index, ok := sarifRuleIndexes[category]
+
+		// then
+		assert.True(t, ok, "no SARIF rule for category %q", category)
+		assert.Less(t, index, len(sarifRules))
+	}
+}
+
+func Test_BuildSARIF_RegionHasLineColumnAndSnippet(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// trailing", LineNumber: 7, Column: 12, EndLine: 7, EndColumn: 23, FilePath: "./src/main.go", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	log := BuildSARIF(comments, "")
+
+	// then
+	location := log.Runs[0].Results[0].Locations[0].PhysicalLocation
+	assert.Equal(t, "src/main.go", location.ArtifactLocation.URI)
+	assert.Equal(t, 7, location.Region.StartLine)
+	assert.Equal(t, 12, location.Region.StartColumn)
+	assert.Equal(t, 7, location.Region.EndLine)
+	assert.Equal(t, 23, location.Region.EndColumn)
+	assert.Equal(t, "// trailing", location.Region.Snippet.Text)
+}
+
+func Test_FormatSARIF_NoComments_EmitsValidLogWithEmptyResults(t *testing.T) {
+	// given
+	var comments []models.CommentInfo
+
+	// when
+	result, err := FormatSARIF(comments, "dev")
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &decoded))
+
+	// then
+	assert.Equal(t, "2.1.0", decoded["version"])
+	runs := decoded["runs"].([]any)
+	require.Len(t, runs, 1)
+	assert.Equal(t, []any{}, runs[0].(map[string]any)["results"])
+}
+
+func Test_BuildSARIF_PragmaAllowedComment_IsSuppressedInSource(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// rationale", LineNumber: 3, FilePath: "a.go", CommentType: models.CommentTypeLine,
+			Metadata: map[string]string{
+				models.MetadataAllowedBy:    "pragma",
+				models.MetadataPragma:       "allow",
+				models.MetadataPragmaReason: "SEC-12",
+			}},
+		{Text: "// plain", LineNumber: 4, FilePath: "a.go", CommentType: models.CommentTypeLine},
+	}
+
+	// when
+	log := BuildSARIF(comments, "1.2.3")
+
+	// then
+	results := log.Runs[0].Results
+	require.Len(t, results, 2)
+	assert.Equal(t, []SARIFSuppression{{Kind: "inSource", Justification: "SEC-12"}}, results[0].Suppressions)
+	assert.Empty(t, results[1].Suppressions)
+}
+
+func Test_BuildSARIF_Severity_SetsResultLevel(t *testing.T) {
+	// given
+	comments := []models.CommentInfo{
+		{Text: "// a", LineNumber: 1, FilePath: "a.go", Severity: models.SeverityError},
+		{Text: "// b", LineNumber: 2, FilePath: "a.go", Severity: models.SeverityWarning},
+		{Text: "// c", LineNumber: 3, FilePath: "a.go", Severity: models.SeverityInfo},
+		{Text: "// d", LineNumber: 4, FilePath: "a.go"},
+	}
+
+	// when
+	log := BuildSARIF(comments, "1.2.3")
+
+	// then
+	var levels []string
+	for _, result := range log.Runs[0].Results {
+		levels = append(levels, result.Level)
+	}
+	assert.Equal(t, []string{"error", "warning", "note", "error"}, levels)
+}
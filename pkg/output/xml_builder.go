@@ -8,6 +8,10 @@ import (
 )
 
 // BuildCommentsXML builds <comments> XML block for a given file and its comments.
+// Comments spanning several lines also carry an end-line-number attribute,
+// comments whose line numbers are relative to an edit snippet carry line-origin,
+// commented-out code is marked with commented-out-code, and findings that do
+// not block carry their severity.
 // Returns XML formatted string with comments, or empty string if no comments provided.
 func BuildCommentsXML(comments []models.CommentInfo, filePath string) string {
 	if len(comments) == 0 {
@@ -17,7 +21,20 @@ func BuildCommentsXML(comments []models.CommentInfo, filePath string) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("<comments file=\"%s\">\n", filePath))
 	for _, comment := range comments {
-		sb.WriteString(fmt.Sprintf("\t<comment line-number=\"%d\">%s</comment>\n", comment.LineNumber, comment.Text))
+		sb.WriteString(fmt.Sprintf("\t<comment line-number=\"%d\"", comment.LineNumber))
+		if comment.LastLine() > comment.LineNumber {
+			sb.WriteString(fmt.Sprintf(" end-line-number=\"%d\"", comment.LastLine()))
+		}
+		if origin := comment.Metadata[models.MetadataLineOrigin]; origin != "" {
+			sb.WriteString(fmt.Sprintf(" line-origin=\"%s\"", origin))
+		}
+		if comment.IsCommentedOutCode() {
+			sb.WriteString(" commented-out-code=\"true\"")
+		}
+		if !comment.IsError() {
+			sb.WriteString(fmt.Sprintf(" severity=\"%s\"", comment.Severity))
+		}
+		sb.WriteString(fmt.Sprintf(">%s</comment>\n", comment.Text))
 	}
 	sb.WriteString("</comments>")
 
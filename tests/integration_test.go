@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"encoding/json"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/core"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/filters"
+	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/git/gittest"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/models"
 	"github.com/code-yeongyu/go-claude-code-comment-checker/pkg/output"
 	"github.com/stretchr/testify/assert"
@@ -235,10 +238,692 @@ func Test_CLI_MultiEdit_WithComment_ExitTwo(t *testing.T) {
 	}
 }
 
+func Test_CLI_EditTool_FileOnDisk_ReportsFileLineNumber(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("import os\n\n\ndef f():\n    # explain\n    return 1\n"), 0o644))
+	input := `{"tool_name":"Edit","cwd":"` + dir + `","tool_input":{"file_path":"app.py","old_string":"    return 0","new_string":"    # explain\n    return 1"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, string(output), `<comment line-number="5">`)
+}
+
+func Test_CLI_EditTool_HashInsideString_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("TEMPLATE = \"\"\"\n# Title\nbody\n\"\"\"\n"), 0o644))
+	input := `{"tool_name":"Edit","cwd":"` + dir + `","tool_input":{"file_path":"app.py","old_string":"# Heading\nbody","new_string":"# Title\nbody"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0: the edit only changes string contents")
+	assert.Contains(t, string(output), "Success")
+}
+
+func Test_CLI_EditTool_PreToolUse_ReportsProposedFileLineNumber(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc f() int {\n\treturn 0\n}\n"), 0o644))
+	input := `{"tool_name":"Edit","hook_event_name":"PreToolUse","cwd":"` + dir + `","tool_input":{"file_path":"main.go","old_string":"\treturn 0","new_string":"\t// answer\n\treturn 42"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), `"permissionDecision":"deny"`)
+	assert.Contains(t, string(output), `"line_number":4`)
+}
+
+func Test_CLI_EditTool_SecondCopyOfExistingComment_ExitTwo(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Edit","tool_input":{"file_path":"test.py","old_string":"# TODO\nx = 1","new_string":"# TODO\nx = 1\n# TODO\ny = 2"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 2, exitErr.ExitCode(), "Expected exit code 2 for the duplicated comment")
+	} else {
+		t.Fatalf("Expected ExitError with code 2, got: %v", err)
+	}
+	assert.Contains(t, string(output), `<comment line-number="3" line-origin="snippet"># TODO</comment>`)
+	assert.NotContains(t, string(output), `<comment line-number="1"`)
+}
+
+func Test_CLI_EditTool_MovedComment_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Edit","tool_input":{"file_path":"test.py","old_string":"# retry on timeout\nx = 1\ny = 2","new_string":"x = 1\n# retry on timeout\ny = 2"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	err := cmd.Run()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 for a comment that only moved")
+}
+
+func Test_CLI_EditTool_FileMissing_MarksSnippetLineNumbers(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Edit","cwd":"` + t.TempDir() + `","tool_input":{"file_path":"app.py","old_string":"x","new_string":"# comment\ny"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, string(output), `<comment line-number="1" line-origin="snippet">`)
+}
+
+func Test_CLI_MultiEdit_CommentAddedThenRemoved_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("x = 1\ny = 2\n"), 0o644))
+	input := `{"tool_name":"MultiEdit","hook_event_name":"PreToolUse","cwd":"` + dir + `","tool_input":{"file_path":"app.py","edits":[` +
+		`{"old_string":"x = 1","new_string":"x = 1  # temp"},` +
+		`{"old_string":"x = 1  # temp","new_string":"x = 10"}]}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err)
+	assert.NotContains(t, string(output), "deny")
+}
+
+func Test_CLI_MultiEdit_ReplaceAll_ReportsEveryCopy(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("f()  # call\nf()  # call\nf()  # call\n"), 0o644))
+	input := `{"tool_name":"MultiEdit","cwd":"` + dir + `","tool_input":{"file_path":"app.py","edits":[` +
+		`{"old_string":"f()","new_string":"f()  # call","replace_all":true}]}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, string(output), `<comment line-number="1"># call</comment>`)
+	assert.Contains(t, string(output), `<comment line-number="2"># call</comment>`)
+	assert.Contains(t, string(output), `<comment line-number="3"># call</comment>`)
+}
+
+func Test_CLI_Edit_PostToolUseReplaceAll_IgnoresExistingNewStringComments(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sum.go"), []byte("package sum\n\n// total is reported by the caller\nfunc Sum(xs []int) (total int) {\n\tfor _, x := range xs {\n\t\ttotal += x\n\t}\n\treturn total\n}\n"), 0o644))
+	input := `{"tool_name":"Edit","hook_event_name":"PostToolUse","cwd":"` + dir + `","tool_input":{"file_path":"sum.go","old_string":"acc","new_string":"total","replace_all":true}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, string(output))
+}
+
 func Test_CLI_BDDComment_ExitZero(t *testing.T) {
 	// given
 	binaryPath := getBinaryPath(t)
-	input := `{"tool_name":"Write","tool_input":{"file_path":"test.py","content":"# given\nprint(1)"}}`
+	input := `{"tool_name":"Write","tool_input":{"file_path":"test.py","content":"# given\nprint(1)"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 for BDD comment")
+	assert.Contains(t, string(output), "Success")
+}
+
+func Test_CLI_HookJSON_WithComment_BlockDecisionExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Write","hook_event_name":"PostToolUse","tool_input":{"file_path":"test.py","content":"# comment\nprint(1)"}}`
+
+	cmd := exec.Command(binaryPath, "--output-format", "hook-json")
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.NoError(t, err, "Expected exit 0 with hook-json output")
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(output, &decoded))
+	assert.Equal(t, "block", decoded["decision"])
+	assert.Contains(t, decoded["reason"], "# comment")
+	assert.Len(t, decoded["comments"], 1)
+}
+
+func Test_CLI_HookJSON_NonBlocking_AdditionalContextOnly(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Write","tool_input":{"file_path":"test.py","content":"# comment\nprint(1)"}}`
+
+	cmd := exec.Command(binaryPath, "--output-format=hook-json", "--non-blocking")
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.NoError(t, err, "Expected exit 0 with hook-json output")
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(output, &decoded))
+	assert.NotContains(t, decoded, "decision")
+	specific := decoded["hookSpecificOutput"].(map[string]any)
+	assert.Equal(t, "PostToolUse", specific["hookEventName"])
+	assert.Contains(t, specific["additionalContext"], "# comment")
+}
+
+func Test_CLI_PreToolUse_WithComment_DeniesPermission(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Edit","hook_event_name":"PreToolUse","tool_input":{"file_path":"test.py","old_string":"x = 1","new_string":"# set x\nx = 2"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.NoError(t, err, "Expected exit 0 with permission decision JSON")
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(output, &decoded))
+	specific := decoded["hookSpecificOutput"].(map[string]any)
+	assert.Equal(t, "PreToolUse", specific["hookEventName"])
+	assert.Equal(t, "deny", specific["permissionDecision"])
+	assert.Contains(t, specific["permissionDecisionReason"], "# set x")
+}
+
+func Test_CLI_PreToolUse_NoComment_ExitZeroNoOutput(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Write","hook_event_name":"PreToolUse","tool_input":{"file_path":"test.py","content":"print(1)"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	assert.NoError(t, err)
+	assert.Empty(t, string(output))
+}
+
+// ============================================================================
+// SCAN SUBCOMMAND TESTS
+// ============================================================================
+
+func Test_CLI_Scan_DirectoryWithComment_ExitOne(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("# regular comment\nprint(1)\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("# not code\n"), 0o644))
+
+	cmd := exec.Command(binaryPath, "scan", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 1, exitErr.ExitCode(), "Expected exit code 1 for findings")
+	} else {
+		t.Fatalf("Expected ExitError with code 1, got: %v", err)
+	}
+	assert.Contains(t, string(output), "app.py")
+	assert.Contains(t, string(output), "1: # regular comment")
+	assert.NotContains(t, string(output), "notes.txt")
+}
+
+func Test_CLI_Scan_CleanDirectory_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("# given\nprint(1)\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "node_modules"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "node_modules", "dep.js"), []byte("// vendored\n"), 0o644))
+
+	cmd := exec.Command(binaryPath, "scan", dir)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 for clean directory")
+	assert.Contains(t, string(output), "Success")
+}
+
+func Test_CLI_Scan_MissingPath_ExitOne(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	cmd := exec.Command(binaryPath, "scan", filepath.Join(t.TempDir(), "missing"))
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.Error(t, err, "Expected non-zero exit for missing path")
+	assert.Contains(t, string(output), "Error")
+}
+
+func Test_CLI_Subcommand_UsageError_ExitOne(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"unknown flag", []string{"scan", "--formt", "json", "."}},
+		{"too many args", []string{"diff", "a..b", "c..d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			binaryPath := getBinaryPath(t)
+			cmd := exec.Command(binaryPath, tt.args...)
+
+			// when
+			err := cmd.Run()
+
+			// then
+			var exitErr *exec.ExitError
+			require.ErrorAs(t, err, &exitErr)
+			assert.Equal(t, 1, exitErr.ExitCode())
+		})
+	}
+}
+
+func Test_CLI_Hook_UsageError_FailsOpen(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	cmd := exec.Command(binaryPath, "--bogus")
+	cmd.Stdin = strings.NewReader("{}")
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), "Skipping")
+}
+
+func Test_CLI_Scan_JSONFormat_ReportsFindingsAndAllowed(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, "app.py", "# given\nx = 1  # Changed from 0 to 1\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--format", "json", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 1, exitErr.ExitCode(), "Expected exit code 1 for findings")
+	} else {
+		t.Fatalf("Expected ExitError with code 1, got: %v", err)
+	}
+	var report struct {
+		Version int `json:"version"`
+		Summary struct {
+			Files    int `json:"files"`
+			Findings int `json:"findings"`
+			Allowed  int `json:"allowed"`
+		} `json:"summary"`
+		Results []struct {
+			Line      int    `json:"line"`
+			Column    int    `json:"column"`
+			Flagged   bool   `json:"flagged"`
+			AllowedBy string `json:"allowed_by"`
+			AgentMemo bool   `json:"agent_memo"`
+		} `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(output, &report))
+	assert.Equal(t, 1, report.Version)
+	assert.Equal(t, 1, report.Summary.Files)
+	assert.Equal(t, 1, report.Summary.Findings)
+	assert.Equal(t, 1, report.Summary.Allowed)
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, "bdd", report.Results[0].AllowedBy)
+	assert.Equal(t, 2, report.Results[1].Line)
+	assert.Equal(t, 8, report.Results[1].Column)
+	assert.True(t, report.Results[1].Flagged)
+	assert.True(t, report.Results[1].AgentMemo)
+}
+
+func Test_CLI_Scan_SARIFFormat_EmitsResults(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// helper comment\n}\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--format=sarif", "main.go")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 1, exitErr.ExitCode(), "Expected exit code 1 for findings")
+	} else {
+		t.Fatalf("Expected ExitError with code 1, got: %v", err)
+	}
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	require.NoError(t, json.Unmarshal(output, &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs[0].Results, 1)
+	result := log.Runs[0].Results[0]
+	assert.Equal(t, "comment", result.RuleID)
+	assert.Equal(t, "main.go", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, 4, result.Locations[0].PhysicalLocation.Region.StartLine)
+}
+
+// ============================================================================
+// FIX SUBCOMMAND TESTS
+// ============================================================================
+
+func Test_CLI_Fix_RemovesCommentsAndKeepsAllowed(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.py")
+	require.NoError(t, os.WriteFile(path, []byte("# given\nx = 1  # set x\n# explain\nprint(x)\n"), 0o644))
+
+	cmd := exec.Command(binaryPath, "fix", dir)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 when all comments are removed")
+	assert.Contains(t, string(output), "Removed 2 comment(s) from 1 file(s)")
+	content, readErr := os.ReadFile(path)
+	require.NoError(t, readErr)
+	assert.Equal(t, "# given\nx = 1\nprint(x)\n", string(content))
+}
+
+func Test_CLI_Fix_RemovesOnlyErrorFindings(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "severity:\n  todo: warning\n")
+	original := "// Copyright 2024 Acme Inc. All rights reserved.\n\npackage main\n\nfunc main() {\n\t// TODO: cache this\n\t// start\n\tprintln(1)\n}\n"
+	gittest.WriteFile(t, dir, "main.go", original)
+
+	cmd := exec.Command(binaryPath, "fix", ".")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.NoError(t, err, string(output))
+	content, readErr := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, readErr)
+	assert.Equal(t, strings.Replace(original, "\t// start\n", "", 1), string(content))
+}
+
+func Test_CLI_Fix_KeepsGoDirectives(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "g.go")
+	original := "//go:build linux\n\npackage g\n\nimport _ \"embed\"\n\n//go:generate stringer -type=Kind\n\n//go:embed data.txt\nvar data string\n\nfunc f() {\n\t// start\n\tprintln(data)\n}\n"
+	require.NoError(t, os.WriteFile(path, []byte(original), 0o644))
+
+	cmd := exec.Command(binaryPath, "fix", path)
+
+	// when
+	output, _ := cmd.CombinedOutput()
+
+	// then
+	assert.Contains(t, string(output), "Removed 1 comment(s)")
+	content, readErr := os.ReadFile(path)
+	require.NoError(t, readErr)
+	assert.Equal(t, strings.Replace(original, "\t// start\n", "", 1), string(content))
+}
+
+func Test_CLI_Fix_DryRun_PrintsDiffWithoutWriting(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	original := "package main\n\nfunc main() {\n\t// start\n\tprintln(1)\n}\n"
+	require.NoError(t, os.WriteFile(path, []byte(original), 0o644))
+
+	cmd := exec.Command(binaryPath, "fix", "--dry-run", path)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), "+++ b/"+path)
+	assert.Contains(t, string(output), "-\t// start\n")
+	content, readErr := os.ReadFile(path)
+	require.NoError(t, readErr)
+	assert.Equal(t, original, string(content))
+}
+
+func Test_CLI_Scan_Fix_ReportsRemainingFindings(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.py")
+	require.NoError(t, os.WriteFile(path, []byte("# note\nprint(1)\n"), 0o644))
+
+	cmd := exec.Command(binaryPath, "scan", "--fix", dir)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 once the comment is removed")
+	assert.Contains(t, string(output), "No problematic comments")
+	content, readErr := os.ReadFile(path)
+	require.NoError(t, readErr)
+	assert.Equal(t, "print(1)\n", string(content))
+}
+
+// ============================================================================
+// DIFF SUBCOMMAND TESTS
+// ============================================================================
+
+func Test_CLI_Diff_WorkingTree_ReportsOnlyAddedComments(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "app.py", "# legacy comment\nprint(1)\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.Run(t, dir, "commit", "-q", "-m", "init")
+	gittest.WriteFile(t, dir, "app.py", "# legacy comment\nprint(1)\n# fresh comment\n")
+
+	cmd := exec.Command(binaryPath, "diff")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 1, exitErr.ExitCode(), "Expected exit code 1 for added comment")
+	} else {
+		t.Fatalf("Expected ExitError with code 1, got: %v", err)
+	}
+	assert.Contains(t, string(output), "3: # fresh comment")
+	assert.NotContains(t, string(output), "legacy comment")
+}
+
+func Test_CLI_Diff_StagedWithoutNewComments_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "app.py", "# legacy comment\nprint(1)\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.Run(t, dir, "commit", "-q", "-m", "init")
+	gittest.WriteFile(t, dir, "app.py", "# legacy comment\nprint(2)\n")
+	gittest.Run(t, dir, "add", ".")
+
+	cmd := exec.Command(binaryPath, "diff", "--staged")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 when no comments were added")
+	assert.Contains(t, string(output), "Success")
+}
+
+func Test_CLI_Diff_CommitRange_ReadsHeadRevision(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "main.go", "package main\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.Run(t, dir, "commit", "-q", "-m", "init")
+	gittest.Run(t, dir, "tag", "base")
+	gittest.WriteFile(t, dir, "main.go", "package main\n\n// range comment\nfunc main() {}\n")
+	gittest.Run(t, dir, "commit", "-q", "-am", "add comment")
+	gittest.WriteFile(t, dir, "main.go", "package main\n")
+
+	cmd := exec.Command(binaryPath, "diff", "base..HEAD")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 1, exitErr.ExitCode(), "Expected exit code 1 for comment in range")
+	} else {
+		t.Fatalf("Expected ExitError with code 1, got: %v", err)
+	}
+	assert.Contains(t, string(output), "3: // range comment")
+}
+
+// ============================================================================
+// PRE-COMMIT SUBCOMMAND TESTS
+// ============================================================================
+
+func Test_CLI_PreCommit_StagedComment_ExitOneCompactOutput(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "src/app.py", "print(1)\n# staged comment\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.WriteFile(t, dir, "src/app.py", "print(1)\n")
+
+	cmd := exec.Command(binaryPath, "pre-commit", "src/app.py", "README.md")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 1, exitErr.ExitCode(), "Expected exit code 1 for staged comment")
+	} else {
+		t.Fatalf("Expected ExitError with code 1, got: %v", err)
+	}
+	assert.Equal(t, "src/app.py:2: # staged comment\n", string(output))
+}
+
+func Test_CLI_PreCommit_UnstagedCommentOnly_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := gittest.InitRepo(t)
+	gittest.WriteFile(t, dir, "app.py", "print(1)\n")
+	gittest.Run(t, dir, "add", ".")
+	gittest.WriteFile(t, dir, "app.py", "print(1)\n# not staged\n")
+
+	cmd := exec.Command(binaryPath, "pre-commit", "app.py")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 when staged content is clean")
+	assert.Empty(t, string(output))
+}
+
+// ============================================================================
+// CONFIGURATION FILE TESTS
+// ============================================================================
+
+func Test_CLI_Config_AllowPatternFromCwd_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "allow_patterns: [\"^# SECURITY:\"]\ndocstrings: false\n")
+	input := `{"tool_name":"Write","cwd":"` + filepath.ToSlash(dir) + `","tool_input":{"file_path":"app.py","content":"\"\"\"Doc.\"\"\"\n# SECURITY: constant-time compare\nprint(1)"}}`
 
 	cmd := exec.Command(binaryPath)
 	cmd.Stdin = strings.NewReader(input)
@@ -247,10 +932,352 @@ func Test_CLI_BDDComment_ExitZero(t *testing.T) {
 	output, err := cmd.CombinedOutput()
 
 	// then
-	assert.NoError(t, err, "Expected exit 0 for BDD comment")
+	assert.NoError(t, err, "Expected exit 0 when config allows the comment")
+	assert.Contains(t, string(output), "Success")
+}
+
+func Test_CLI_Config_DenyPatternOverridesBDD_ExitTwo(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "overrides:\n  - files: [\"*.py\"]\n    deny_patterns: [\"(?i)given\"]\n")
+	input := `{"tool_name":"Write","tool_input":{"file_path":"` + filepath.ToSlash(filepath.Join(dir, "sub", "test_app.py")) + `","content":"# given\nprint(1)"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	err := cmd.Run()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 2, exitErr.ExitCode(), "Expected exit code 2 for denied comment")
+	} else {
+		t.Fatalf("Expected ExitError with code 2, got: %v", err)
+	}
+}
+
+func Test_CLI_Config_PublicAPIDocsAllow_FlagsOnlyPrivateDocs(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "public_api_docs: allow\n")
+	gittest.WriteFile(t, dir, "api.go", "package api\n\n// Run starts the service.\nfunc Run() {}\n\n// helper does the work.\nfunc helper() {}\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--format", "json", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.Error(t, err, "Expected exit 1 for the private doc comment")
+	var report struct {
+		Results []struct {
+			Text      string `json:"text"`
+			Flagged   bool   `json:"flagged"`
+			AllowedBy string `json:"allowed_by"`
+		} `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(output, &report))
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, "// Run starts the service.", report.Results[0].Text)
+	assert.Equal(t, "public-api-doc", report.Results[0].AllowedBy)
+	assert.Equal(t, "// helper does the work.", report.Results[1].Text)
+	assert.True(t, report.Results[1].Flagged)
+}
+
+func Test_CLI_Config_TodosTracked_FlagsOnlyBareTodos(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "todos: tracked\n")
+	gittest.WriteFile(t, dir, "main.py", "x = 1  # TODO(PROJ-12): drop after migration\ny = 2  # TODO: fix later\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--format", "json", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.Error(t, err, "Expected exit 1 for the bare TODO")
+	var report struct {
+		Results []struct {
+			Text      string `json:"text"`
+			Flagged   bool   `json:"flagged"`
+			AllowedBy string `json:"allowed_by"`
+		} `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(output, &report))
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, "todo", report.Results[0].AllowedBy)
+	assert.Equal(t, "# TODO: fix later", report.Results[1].Text)
+	assert.True(t, report.Results[1].Flagged)
+}
+
+func Test_CLI_Config_AgentMemosOnly_FlagsOnlyMemos(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "agent_memos: only\n")
+	gittest.WriteFile(t, dir, "main.py", "# Calculate the total\nx = 1\n# Changed from list to set\ny = set()\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--format", "json", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.Error(t, err, "Expected exit 1 for the memo")
+	var report struct {
+		Results []struct {
+			Text             string `json:"text"`
+			Flagged          bool   `json:"flagged"`
+			AllowedBy        string `json:"allowed_by"`
+			AgentMemo        bool   `json:"agent_memo"`
+			AgentMemoPattern string `json:"agent_memo_pattern"`
+		} `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(output, &report))
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, "non-memo", report.Results[0].AllowedBy)
+	assert.False(t, report.Results[0].AgentMemo)
+	assert.True(t, report.Results[1].Flagged)
+	assert.Equal(t, "changed-from-to", report.Results[1].AgentMemoPattern)
+}
+
+func Test_CLI_Config_AgentMemoLanguages_DetectsJapaneseMemo(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "agent_memos: only\nagent_memo_languages:\n  add: [ja]\nagent_memo_script_detection: true\n")
+	gittest.WriteFile(t, dir, "main.py", "# 合計を計算する\nx = 1\n# リストからセットに変更しました\ny = set()\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--format", "json", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.Error(t, err, "Expected exit 1 for the Japanese memo")
+	var report struct {
+		Results []struct {
+			Flagged          bool   `json:"flagged"`
+			AgentMemoPattern string `json:"agent_memo_pattern"`
+		} `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(output, &report))
+	require.Len(t, report.Results, 2)
+	assert.False(t, report.Results[0].Flagged)
+	assert.True(t, report.Results[1].Flagged)
+	assert.Equal(t, "ja-changed", report.Results[1].AgentMemoPattern)
+}
+
+func Test_CLI_Hook_WarningSeverity_PassesContextWithoutBlocking(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "severity:\n  docstring: warning\n")
+	input := `{"tool_name":"Write","cwd":"` + dir + `","tool_input":{"file_path":"greet.py","content":"def greet():\n    \"\"\"Greets the user.\"\"\"\n    return 1\n"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.NoError(t, err, "Expected exit 0 for warnings only")
+	var result struct {
+		Decision           string `json:"decision"`
+		HookSpecificOutput struct {
+			AdditionalContext string `json:"additionalContext"`
+		} `json:"hookSpecificOutput"`
+	}
+	require.NoError(t, json.Unmarshal(output, &result))
+	assert.Empty(t, result.Decision)
+	assert.Contains(t, result.HookSpecificOutput.AdditionalContext, `severity="warning"`)
+}
+
+func Test_CLI_Hook_ErrorSeverity_StillBlocks(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "severity:\n  docstring: warning\n")
+	input := `{"tool_name":"Write","cwd":"` + dir + `","tool_input":{"file_path":"greet.py","content":"def greet():\n    \"\"\"Greets the user.\"\"\"\n    # Changed from print to return\n    return 1\n"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.Error(t, err)
+	exitErr, ok := err.(*exec.ExitError)
+	require.True(t, ok)
+	assert.Equal(t, 2, exitErr.ExitCode())
+	assert.Contains(t, string(output), "# Changed from print to return")
+	assert.Contains(t, string(output), `severity="warning">"""Greets the user."""`)
+}
+
+func Test_CLI_Scan_InfoSeverity_ReportsWithoutFailing(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "severity:\n  todo: info\n")
+	gittest.WriteFile(t, dir, "main.py", "x = 1  # TODO: fix later\n")
+
+	cmd := exec.Command(binaryPath, "scan", dir)
+
+	// when
+	output, err := cmd.Output()
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "# TODO: fix later [info]")
+}
+
+func Test_CLI_Baseline_GrandfathersExistingCommentsAndReportsStale(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// legacy one\n\t// legacy two\n}\n")
+	create := exec.Command(binaryPath, "baseline", "create")
+	create.Dir = dir
+	createOutput, err := create.CombinedOutput()
+	require.NoError(t, err, string(createOutput))
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// legacy one\n\t// brand new\n}\n")
+
+	cmd := exec.Command(binaryPath, "scan")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.Error(t, err, "Expected exit 1 for the new comment")
+	assert.Contains(t, string(output), "// brand new")
+	assert.NotContains(t, string(output), "5: // legacy one")
+	assert.Contains(t, string(output), "stale baseline")
+	assert.Contains(t, string(output), "main.go (main): // legacy two")
+}
+
+func Test_CLI_Baseline_ScanFixKeepsGrandfatheredComments(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// legacy one\n}\n")
+	create := exec.Command(binaryPath, "baseline", "create")
+	create.Dir = dir
+	createOutput, err := create.CombinedOutput()
+	require.NoError(t, err, string(createOutput))
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// legacy one\n\t// brand new\n}\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--fix")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.NoError(t, err, string(output))
+	assert.NotContains(t, string(output), "stale baseline")
+	content, readErr := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, readErr)
+	assert.Equal(t, "package main\n\nfunc main() {\n\t// legacy one\n}\n", string(content))
+}
+
+func Test_CLI_Baseline_FixKeepsGrandfatheredComments(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// legacy one\n}\n")
+	create := exec.Command(binaryPath, "baseline", "create")
+	create.Dir = dir
+	createOutput, err := create.CombinedOutput()
+	require.NoError(t, err, string(createOutput))
+	gittest.WriteFile(t, dir, "main.go", "package main\n\nfunc main() {\n\t// legacy one\n\t// brand new\n}\n")
+
+	cmd := exec.Command(binaryPath, "fix")
+	cmd.Dir = dir
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.NoError(t, err, string(output))
+	content, readErr := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, readErr)
+	assert.Equal(t, "package main\n\nfunc main() {\n\t// legacy one\n}\n", string(content))
+}
+
+func Test_CLI_Pragmas_SuppressAndListForAudit(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, "crypto.go", "package crypto\n\nfunc f() {\n\t// comment-checker: allow-next-line SEC-12\n\t// constant-time compare prevents timing attacks\n}\n")
+
+	cmd := exec.Command(binaryPath, "scan", "--list-suppressed", dir)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	require.NoError(t, err, string(output))
+	assert.Contains(t, string(output), "crypto.go:5: // constant-time compare prevents timing attacks [allow-next-line: SEC-12]")
+}
+
+func Test_CLI_Hook_AllowPragma_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Write","tool_input":{"file_path":"/tmp/crypto.py","content":"key = derive(pw)  # PBKDF2 per policy  comment-checker: allow\n"}}`
+
+	cmd := exec.Command(binaryPath)
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, string(output))
+}
+
+func Test_CLI_Scan_IgnoredLanguage_ExitZero(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	dir := t.TempDir()
+	gittest.WriteFile(t, dir, ".comment-checker.yaml", "ignore_languages: [yaml]\n")
+	gittest.WriteFile(t, dir, "ci.yml", "# pipeline settings\nkey: value\n")
+
+	cmd := exec.Command(binaryPath, "scan", dir)
+
+	// when
+	output, err := cmd.CombinedOutput()
+
+	// then
+	assert.NoError(t, err, "Expected exit 0 for ignored language")
 	assert.Contains(t, string(output), "Success")
 }
 
+func Test_CLI_DisableFilterFlag_ReportsBDDComment(t *testing.T) {
+	// given
+	binaryPath := getBinaryPath(t)
+	input := `{"tool_name":"Write","tool_input":{"file_path":"test.py","content":"# given\nprint(1)"}}`
+
+	cmd := exec.Command(binaryPath, "--disable-filter", "bdd")
+	cmd.Stdin = strings.NewReader(input)
+
+	// when
+	err := cmd.Run()
+
+	// then
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		assert.Equal(t, 2, exitErr.ExitCode(), "Expected exit code 2 with bdd filter disabled")
+	} else {
+		t.Fatalf("Expected ExitError with code 2, got: %v", err)
+	}
+}
+
 // ============================================================================
 // MULTI-LANGUAGE DETECTION TESTS
 // ============================================================================
@@ -472,26 +1499,15 @@ int main() { return 0; }`
 // HELPER FUNCTIONS
 // ============================================================================
 
+// applyFilterChain records agent memo verdicts and applies the default filters,
+// like the CLI pipeline does.
 func applyFilterChain(comments []models.CommentInfo) []models.CommentInfo {
-	bddFilter := filters.NewBDDFilter()
-	directiveFilter := filters.NewDirectiveFilter()
-	shebangFilter := filters.NewShebangFilter()
-
-	var filtered []models.CommentInfo
+	agentMemoFilter := filters.NewAgentMemoFilter()
+	annotated := make([]models.CommentInfo, 0, len(comments))
 	for _, c := range comments {
-		if bddFilter.ShouldSkip(c) {
-			continue
-		}
-		if directiveFilter.ShouldSkip(c) {
-			continue
-		}
-		if shebangFilter.ShouldSkip(c) {
-			continue
-		}
-		filtered = append(filtered, c)
+		annotated = append(annotated, agentMemoFilter.Annotate(c))
 	}
-
-	return filtered
+	return filters.NewDefaultRegistry().Apply(annotated)
 }
 
 func Test_FullPipeline_WithAgentMemo_DetectsAsCodeSmell(t *testing.T) {
@@ -508,6 +1524,8 @@ print("hello")`
 	// then
 	assert.Len(t, filtered, 1)
 	assert.True(t, agentMemoFilter.IsAgentMemo(filtered[0]))
+	assert.Equal(t, "true", filtered[0].Metadata[models.MetadataAgentMemo])
+	assert.Equal(t, "changed-from-to", filtered[0].Metadata[models.MetadataAgentMemoPattern])
 }
 
 func Test_FullPipeline_WithAgentMemo_FormatterIncludesWarning(t *testing.T) {
@@ -556,4 +1574,5 @@ print("hello")`
 	// then
 	assert.Len(t, filtered, 1)
 	assert.False(t, agentMemoFilter.IsAgentMemo(filtered[0]))
+	assert.Equal(t, "false", filtered[0].Metadata[models.MetadataAgentMemo])
 }